@@ -10,7 +10,6 @@ import (
 
 	k8slog "github.com/outscale/goutils/k8s/log"
 	"github.com/outscale/goutils/sdk/batch"
-	"github.com/outscale/goutils/sdk/log"
 	osc "github.com/outscale/osc-sdk-go/v3/pkg/osc"
 )
 
@@ -20,30 +19,46 @@ type BatcherByID[R any] = batch.BatcherByID[R]
 
 type BatcherSameQuery[Q, R any] = batch.BatcherSameQuery[Q, R]
 
-func init() {
-	log.Default = k8slog.Logger{}
+type Option = batch.Option
+
+var (
+	WithTracerProvider = batch.WithTracerProvider
+	WithLogger         = batch.WithLogger
+)
+
+// withDefaults logs to the klog logger of the context, unless another logger is set.
+func withDefaults(opts []Option) []Option {
+	return append([]Option{batch.WithLogger(k8slog.Logger{})}, opts...)
+}
+
+func NewSnapshotBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Snapshot] {
+	return batch.NewSnapshotBatcherByID(interval, client, withDefaults(opts)...)
+}
+
+func NewSnapshotBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadSnapshotsRequest, osc.ReadSnapshotsResponse] {
+	return batch.NewSnapshotBatcherSameQuery(interval, client, withDefaults(opts)...)
 }
 
-func NewSnapshotBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Snapshot] {
-	return batch.NewSnapshotBatcherByID(interval, client)
+func NewVolumeBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Volume] {
+	return batch.NewVolumeBatcherByID(interval, client, withDefaults(opts)...)
 }
 
-func NewSnapshotBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadSnapshotsRequest, osc.ReadSnapshotsResponse] {
-	return batch.NewSnapshotBatcherSameQuery(interval, client)
+func NewVolumeBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadVolumesRequest, osc.ReadVolumesResponse] {
+	return batch.NewVolumeBatcherSameQuery(interval, client, withDefaults(opts)...)
 }
 
-func NewVolumeBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Volume] {
-	return batch.NewVolumeBatcherByID(interval, client)
+func NewVmBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Vm] {
+	return batch.NewVmBatcherByID(interval, client, withDefaults(opts)...)
 }
 
-func NewVolumeBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadVolumesRequest, osc.ReadVolumesResponse] {
-	return batch.NewVolumeBatcherSameQuery(interval, client)
+func NewVmBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadVmsRequest, osc.ReadVmsResponse] {
+	return batch.NewVmBatcherSameQuery(interval, client, withDefaults(opts)...)
 }
 
-func NewVmBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Vm] {
-	return batch.NewVmBatcherByID(interval, client)
+func NewPublicIpBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.PublicIp] {
+	return batch.NewPublicIpBatcherByID(interval, client, withDefaults(opts)...)
 }
 
-func NewVmBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadVmsRequest, osc.ReadVmsResponse] {
-	return batch.NewVmBatcherSameQuery(interval, client)
+func NewPublicIpBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadPublicIpsRequest, osc.ReadPublicIpsResponse] {
+	return batch.NewPublicIpBatcherSameQuery(interval, client, withDefaults(opts)...)
 }
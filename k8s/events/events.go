@@ -0,0 +1,175 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// Event reasons.
+const (
+	ReasonQuotaExceeded    = "QuotaExceeded"
+	ReasonThrottled        = "Throttled"
+	ReasonInvalidParameter = "InvalidParameter"
+	ReasonNotFound         = "NotFound"
+	// ReasonAPIError is the reason of all other OAPI errors.
+	ReasonAPIError = "OAPIError"
+)
+
+const (
+	// DefaultDedupInterval is the default interval during which identical events are not emitted again.
+	DefaultDedupInterval = 10 * time.Minute
+	// DefaultQPS is the default sustained rate of emitted events.
+	DefaultQPS = 1
+	// DefaultBurst is the default burst of emitted events.
+	DefaultBurst = 10
+
+	// maxMessageLength is the max length of event messages, longer messages being rejected by the API server.
+	maxMessageLength = 1024
+)
+
+// Reason returns the event reason of an OAPI error.
+func Reason(err error) string {
+	err = sdkerrors.Wrap(err)
+	switch {
+	case errors.Is(err, sdkerrors.ErrQuotaExceeded):
+		return ReasonQuotaExceeded
+	case errors.Is(err, sdkerrors.ErrThrottled):
+		return ReasonThrottled
+	case errors.Is(err, sdkerrors.ErrNotFound):
+		return ReasonNotFound
+	case errors.Is(err, sdkerrors.ErrInvalidParameter):
+		return ReasonInvalidParameter
+	default:
+		return ReasonAPIError
+	}
+}
+
+// Options defines the deduplication and rate limiting of events.
+type Options struct {
+	// DedupInterval is the interval during which identical events on the same object are not emitted again.
+	// Defaults to DefaultDedupInterval.
+	DedupInterval time.Duration
+	// QPS and Burst limit the rate of all emitted events. Default to DefaultQPS/DefaultBurst.
+	QPS   float64
+	Burst int
+	// Clock returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+}
+
+func (o *Options) setDefaults() {
+	if o.DedupInterval <= 0 {
+		o.DedupInterval = DefaultDedupInterval
+	}
+	if o.QPS <= 0 {
+		o.QPS = DefaultQPS
+	}
+	if o.Burst <= 0 {
+		o.Burst = DefaultBurst
+	}
+	if o.Clock == nil {
+		o.Clock = time.Now
+	}
+}
+
+type eventKey struct {
+	object, reason, message string
+}
+
+// Recorder emits warning events for OAPI errors, on the objects they relate to.
+type Recorder struct {
+	recorder record.EventRecorder
+	opts     Options
+	limiter  *rate.Limiter
+
+	mu   sync.Mutex
+	sent map[eventKey]time.Time
+}
+
+// NewRecorder builds a recorder emitting events through an event recorder.
+func NewRecorder(recorder record.EventRecorder, opts Options) *Recorder {
+	opts.setDefaults()
+	return &Recorder{
+		recorder: recorder,
+		opts:     opts,
+		limiter:  rate.NewLimiter(rate.Limit(opts.QPS), opts.Burst),
+		sent:     map[eventKey]time.Time{},
+	}
+}
+
+// Error emits a warning event on obj for an OAPI error returned by action (e.g. "create volume").
+// It returns true if the event has been emitted, and false if it has been deduplicated or rate limited.
+func (r *Recorder) Error(obj runtime.Object, action string, err error) bool {
+	if err == nil {
+		return false
+	}
+	reason := Reason(err)
+	msg := fmt.Sprintf("%s: %s", action, errorMessage(err))
+	if len(msg) > maxMessageLength {
+		// the message is cut on a rune boundary, to stay valid UTF-8.
+		n := maxMessageLength - 3
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+		msg = msg[:n] + "..."
+	}
+	if !r.allow(eventKey{object: objectKey(obj), reason: reason, message: msg}) {
+		return false
+	}
+	r.recorder.Event(obj, corev1.EventTypeWarning, reason, msg)
+	return true
+}
+
+func (r *Recorder) allow(key eventKey) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.opts.Clock()
+	for k, t := range r.sent {
+		if now.Sub(t) >= r.opts.DedupInterval {
+			delete(r.sent, k)
+		}
+	}
+	if _, found := r.sent[key]; found {
+		return false
+	}
+	if !r.limiter.AllowN(now, 1) {
+		return false
+	}
+	r.sent[key] = now
+	return true
+}
+
+// errorMessage returns the OAPI error if any, without the wrapping context.
+func errorMessage(err error) string {
+	var oerr *osc.ErrorResponse
+	if errors.As(err, &oerr) {
+		return oerr.Error()
+	}
+	return err.Error()
+}
+
+func objectKey(obj runtime.Object) string {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return fmt.Sprintf("%p", obj)
+	}
+	if uid := acc.GetUID(); uid != "" {
+		return string(uid)
+	}
+	return fmt.Sprintf("%T/%s/%s", obj, acc.GetNamespace(), acc.GetName())
+}
@@ -0,0 +1,87 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package events_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/outscale/goutils/k8s/events"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func oapiError(code, typ string) error {
+	return fmt.Errorf("create volume: HTTP 400: %w", &osc.ErrorResponse{Errors: []osc.Errors{{Code: code, Type: typ}}})
+}
+
+func TestReason(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		reason string
+	}{
+		{err: oapiError("10001", "TooManyResources (QuotaExceded)"), reason: events.ReasonQuotaExceeded},
+		{err: oapiError("", "RequestLimitExceeded"), reason: events.ReasonThrottled},
+		{err: errors.New("unexpected response status 503 Service Unavailable: "), reason: events.ReasonThrottled},
+		{err: oapiError("5064", "InvalidResource"), reason: events.ReasonNotFound},
+		{err: oapiError("4045", "InvalidParameterValue"), reason: events.ReasonInvalidParameter},
+		{err: oapiError("4120", "InvalidParameterValue"), reason: events.ReasonAPIError},
+		{err: errors.New("connection refused"), reason: events.ReasonAPIError},
+	} {
+		assert.Equal(t, tc.reason, events.Reason(tc.err), tc.err.Error())
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo", UID: "uid-foo"}}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo", UID: "uid-bar"}}
+	quota := oapiError("10001", "TooManyResources (QuotaExceded)")
+	t.Run("Events are emitted with a reason from the OAPI error", func(t *testing.T) {
+		fake := record.NewFakeRecorder(10)
+		r := events.NewRecorder(fake, events.Options{})
+		assert.True(t, r.Error(pvc, "create volume", quota))
+		assert.Equal(t, "Warning QuotaExceeded create volume: [10001] TooManyResources (QuotaExceded)", <-fake.Events)
+	})
+	t.Run("Long messages are truncated on rune boundaries", func(t *testing.T) {
+		fake := record.NewFakeRecorder(10)
+		r := events.NewRecorder(fake, events.Options{})
+		assert.True(t, r.Error(pvc, strings.Repeat("é", 600), quota))
+		msg := strings.TrimPrefix(<-fake.Events, "Warning QuotaExceeded ")
+		assert.True(t, utf8.ValidString(msg))
+		assert.LessOrEqual(t, len(msg), 1024)
+		assert.True(t, strings.HasSuffix(msg, "é..."))
+	})
+	t.Run("Identical events are deduplicated until the dedup interval has elapsed", func(t *testing.T) {
+		fake := record.NewFakeRecorder(10)
+		now := time.Now()
+		r := events.NewRecorder(fake, events.Options{DedupInterval: time.Minute, Clock: func() time.Time { return now }})
+		assert.True(t, r.Error(pvc, "create volume", quota))
+		assert.False(t, r.Error(pvc, "create volume", quota))
+		assert.True(t, r.Error(svc, "create volume", quota), "events on other objects are not deduplicated")
+		assert.True(t, r.Error(pvc, "delete volume", quota), "other messages are not deduplicated")
+		now = now.Add(time.Minute)
+		assert.True(t, r.Error(pvc, "create volume", quota))
+		assert.Len(t, fake.Events, 4)
+	})
+	t.Run("Events are rate limited", func(t *testing.T) {
+		fake := record.NewFakeRecorder(10)
+		now := time.Now()
+		r := events.NewRecorder(fake, events.Options{QPS: 1, Burst: 2, Clock: func() time.Time { return now }})
+		for i := range 3 {
+			r.Error(pvc, fmt.Sprintf("create volume %d", i), quota)
+		}
+		assert.Len(t, fake.Events, 2)
+		now = now.Add(time.Second)
+		assert.True(t, r.Error(pvc, "create volume 3", quota))
+	})
+}
@@ -0,0 +1,264 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package gc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/k8s/teardown"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+// DefaultGracePeriod is the default time a resource needs to stay orphan before being collected.
+const DefaultGracePeriod = time.Hour
+
+// Options defines the garbage collector options.
+type Options struct {
+	// GracePeriod is the time a resource needs to stay orphan before being collected. Defaults to DefaultGracePeriod.
+	GracePeriod time.Duration
+	// Delete deletes collected resources. Orphans are only reported if not set.
+	Delete bool
+	// Teardown defines how collected resources are deleted.
+	Teardown teardown.Options
+	// Clock returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+}
+
+// Orphan is a resource whose Kubernetes owner no longer exists.
+type Orphan struct {
+	inventory.Info
+	// Reason explains why the resource is orphan.
+	Reason string
+	// Since is the time the resource was first found orphan.
+	Since time.Time
+}
+
+// Report is the result of a garbage collector run.
+type Report struct {
+	ClusterID string
+	// Orphans lists the orphan resources past the grace period.
+	Orphans []Orphan
+	// Pending lists the orphan resources within the grace period.
+	Pending []Orphan
+	// Plan is the executed deletion plan, if Delete is set.
+	Plan *teardown.Plan
+}
+
+// Collector finds, and optionally deletes, cloud resources created for Kubernetes objects that no longer exist.
+// Load balancers, security groups and public IPs are checked against services and nodes,
+// unlinked volumes created by the CSI driver are checked against persistent volumes.
+// Only resources owned by the cluster are considered.
+//
+// A resource needs to be found orphan by successive runs for the grace period to be collected.
+type Collector struct {
+	client    osc.ClientInterface
+	lister    Lister
+	clusterID string
+	opts      Options
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewCollector creates a garbage collector for a cluster.
+func NewCollector(client osc.ClientInterface, lister Lister, clusterID string, opts Options) *Collector {
+	if opts.GracePeriod == 0 {
+		opts.GracePeriod = DefaultGracePeriod
+	}
+	if opts.Clock == nil {
+		opts.Clock = time.Now
+	}
+	return &Collector{
+		client:    client,
+		lister:    lister,
+		clusterID: clusterID,
+		opts:      opts,
+		seen:      map[string]time.Time{},
+	}
+}
+
+// Run runs the garbage collector once.
+func (c *Collector) Run(ctx context.Context) (*Report, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	logger := klog.FromContext(ctx).WithValues("clusterID", c.clusterID)
+	inv, err := inventory.Read(ctx, c.client, c.clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("gc: %w", err)
+	}
+	// Kubernetes objects are listed after the cloud resources, a resource created for a new object
+	// would otherwise be found orphan.
+	live, err := c.listLive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gc: %w", err)
+	}
+
+	now := c.opts.Clock()
+	report := &Report{ClusterID: c.clusterID}
+	seen := map[string]time.Time{}
+	for _, o := range findOrphans(inv, live) {
+		key := string(o.Type) + "/" + o.ID
+		since, found := c.seen[key]
+		if !found {
+			since = now
+		}
+		seen[key] = since
+		o.Since = since
+		if now.Sub(since) >= c.opts.GracePeriod {
+			report.Orphans = append(report.Orphans, o)
+		} else {
+			report.Pending = append(report.Pending, o)
+		}
+	}
+	// resources no longer orphan, or deleted, are forgotten.
+	c.seen = seen
+	for _, o := range report.Orphans {
+		logger.V(2).Info("Orphan resource found", "type", o.Type, "id", o.ID, "reason", o.Reason, "since", o.Since)
+	}
+	if !c.opts.Delete || len(report.Orphans) == 0 {
+		return report, nil
+	}
+
+	report.Plan = teardown.NewPlan(deletionInventory(inv, report.Orphans))
+	if err := report.Plan.Execute(ctx, c.client, c.opts.Teardown); err != nil {
+		return report, fmt.Errorf("gc: %w", err)
+	}
+	return report, nil
+}
+
+type liveObjects struct {
+	services []inventory.Service
+	nodes    []string
+	volumes  []string
+	pvs      []string
+}
+
+func (c *Collector) listLive(ctx context.Context) (liveObjects, error) {
+	var (
+		live liveObjects
+		err  error
+	)
+	if live.services, err = c.lister.Services(ctx); err != nil {
+		return live, fmt.Errorf("list services: %w", err)
+	}
+	if live.nodes, err = c.lister.Nodes(ctx); err != nil {
+		return live, fmt.Errorf("list nodes: %w", err)
+	}
+	pvs, err := c.lister.PersistentVolumes(ctx)
+	if err != nil {
+		return live, fmt.Errorf("list persistent volumes: %w", err)
+	}
+	for _, pv := range pvs {
+		live.volumes = append(live.volumes, pv.VolumeID)
+		live.pvs = append(live.pvs, pv.Name)
+	}
+	return live, nil
+}
+
+// orphanReason returns why a resource is orphan, or an empty string if it is not.
+// Resources not tagged with a service, a node or a persistent volume are not managed by Kubernetes, and are never orphan.
+func (l liveObjects) orphanReason(info inventory.Info) string {
+	switch {
+	case info.Service != nil:
+		// A service recreated with the same name may reuse the resources of the previous one.
+		if slices.ContainsFunc(l.services, func(s inventory.Service) bool {
+			return (info.Service.ID != "" && s.ID == info.Service.ID) ||
+				(s.Namespace == info.Service.Namespace && s.Name == info.Service.Name)
+		}) {
+			return ""
+		}
+		return fmt.Sprintf("service %s/%s not found", info.Service.Namespace, info.Service.Name)
+	case info.NodeName != "":
+		if slices.Contains(l.nodes, info.NodeName) {
+			return ""
+		}
+		return fmt.Sprintf("node %s not found", info.NodeName)
+	case info.PersistentVolume != "":
+		if slices.Contains(l.volumes, info.ID) || slices.Contains(l.pvs, info.PersistentVolume) {
+			return ""
+		}
+		return fmt.Sprintf("persistent volume %s not found", info.PersistentVolume)
+	default:
+		return ""
+	}
+}
+
+func findOrphans(inv *inventory.Inventory, live liveObjects) []Orphan {
+	var res []Orphan
+	add := func(info inventory.Info, reason string) {
+		if reason != "" && info.Lifecycle == tags.ResourceLifecycleOwned {
+			res = append(res, Orphan{Info: info, Reason: reason})
+		}
+	}
+	orphanLBs := map[string]bool{}
+	for _, lb := range inv.LoadBalancers {
+		reason := live.orphanReason(lb.Info)
+		orphanLBs[lb.ID] = reason != "" && lb.Lifecycle == tags.ResourceLifecycleOwned
+		add(lb.Info, reason)
+	}
+	for _, sg := range inv.SecurityGroups {
+		// security groups used by a live load balancer cannot be deleted.
+		if slices.ContainsFunc(inv.LoadBalancers, func(lb inventory.Resource[osc.LoadBalancer]) bool {
+			return !orphanLBs[lb.ID] && slices.Contains(lb.Object.SecurityGroups, sg.ID)
+		}) {
+			continue
+		}
+		add(sg.Info, live.orphanReason(sg.Info))
+	}
+	for _, ip := range inv.PublicIps {
+		add(ip.Info, live.orphanReason(ip.Info))
+	}
+	for _, vol := range inv.Volumes {
+		if len(vol.Object.LinkedVolumes) > 0 {
+			continue
+		}
+		add(vol.Info, live.orphanReason(vol.Info))
+	}
+	return res
+}
+
+// deletionInventory builds the inventory of resources to delete.
+// Other security groups are kept as shared resources, for rules referencing deleted security groups to be revoked.
+func deletionInventory(inv *inventory.Inventory, orphans []Orphan) *inventory.Inventory {
+	ids := map[string]bool{}
+	for _, o := range orphans {
+		ids[string(o.Type)+"/"+o.ID] = true
+	}
+	isOrphan := func(info inventory.Info) bool {
+		return ids[string(info.Type)+"/"+info.ID]
+	}
+	res := &inventory.Inventory{
+		ClusterID:     inv.ClusterID,
+		LoadBalancers: filter(inv.LoadBalancers, isOrphan),
+		PublicIps:     filter(inv.PublicIps, isOrphan),
+		Volumes:       filter(inv.Volumes, isOrphan),
+	}
+	for _, sg := range inv.SecurityGroups {
+		if !isOrphan(sg.Info) {
+			sg.Lifecycle = tags.ResourceLifecycleShared
+		}
+		res.SecurityGroups = append(res.SecurityGroups, sg)
+	}
+	return res
+}
+
+func filter[T any](rs []inventory.Resource[T], keep func(inventory.Info) bool) []inventory.Resource[T] {
+	var res []inventory.Resource[T]
+	for _, r := range rs {
+		if keep(r.Info) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
@@ -0,0 +1,156 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package gc_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/gc"
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const clusterID = "37286b54-bb4b-46c8-ac8a-37621f1e7123"
+
+func expectInventory(mockSDK *mocks_osc.MockClient) {
+	owned := []osc.ResourceTag{{Key: tags.ClusterIDKey(clusterID), Value: string(tags.ResourceLifecycleOwned)}}
+	svcTags := func(ns, name, id string) []osc.ResourceTag {
+		return append(owned, osc.ResourceTag{Key: tags.Namespace, Value: ns},
+			osc.ResourceTag{Key: tags.ServiceName, Value: name}, osc.ResourceTag{Key: tags.ServiceID, Value: id})
+	}
+	pvTags := func(pv string) []osc.ResourceTag {
+		return append(owned, osc.ResourceTag{Key: tags.CSIVolumeName, Value: pv})
+	}
+	mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Any()).Return(&osc.ReadTagsResponse{Tags: &[]osc.Tag{
+		{ResourceId: "sg-gone", ResourceType: osc.TagResourceTypeSecurityGroup},
+		{ResourceId: "sg-live", ResourceType: osc.TagResourceTypeSecurityGroup},
+		{ResourceId: "eipalloc-gone", ResourceType: osc.TagResourceTypePublicIp},
+		{ResourceId: "vol-pv", ResourceType: osc.TagResourceTypeVolume},
+		{ResourceId: "vol-gone", ResourceType: osc.TagResourceTypeVolume},
+		{ResourceId: "vol-linked", ResourceType: osc.TagResourceTypeVolume},
+		{ResourceId: "vol-unmanaged", ResourceType: osc.TagResourceTypeVolume},
+	}}, nil).AnyTimes()
+	mockSDK.EXPECT().ReadSecurityGroups(gomock.Any(), gomock.Any()).Return(&osc.ReadSecurityGroupsResponse{SecurityGroups: &[]osc.SecurityGroup{
+		{SecurityGroupId: "sg-gone", Tags: svcTags("default", "gone", "uid-gone")},
+		{SecurityGroupId: "sg-live", Tags: svcTags("default", "live", "uid-live")},
+	}}, nil).AnyTimes()
+	mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+		{PublicIpId: "eipalloc-gone", PublicIp: "1.1.1.1", Tags: svcTags("default", "gone", "uid-gone")},
+	}}, nil).AnyTimes()
+	mockSDK.EXPECT().ReadVolumes(gomock.Any(), gomock.Any()).Return(&osc.ReadVolumesResponse{Volumes: &[]osc.Volume{
+		{VolumeId: "vol-pv", Tags: pvTags("pv")},
+		{VolumeId: "vol-gone", Tags: pvTags("pv-gone")},
+		{VolumeId: "vol-linked", Tags: pvTags("pv-linked"), LinkedVolumes: []osc.LinkedVolume{{VmId: "i-foo"}}},
+		{VolumeId: "vol-unmanaged", Tags: owned},
+	}}, nil).AnyTimes()
+	mockSDK.EXPECT().ReadLoadBalancers(gomock.Any(), gomock.Any()).Return(&osc.ReadLoadBalancersResponse{LoadBalancers: &[]osc.LoadBalancer{
+		{LoadBalancerName: "lb-gone", PublicIp: ptr.To("1.1.1.1"), SecurityGroups: []string{"sg-gone"}, Tags: svcTags("default", "gone", "uid-gone")},
+		{LoadBalancerName: "lb-live", SecurityGroups: []string{"sg-live"}, Tags: svcTags("default", "live", "uid-live")},
+	}}, nil).AnyTimes()
+}
+
+func orphanIDs(orphans []gc.Orphan) []string {
+	res := make([]string, 0, len(orphans))
+	for _, o := range orphans {
+		res = append(res, o.ID)
+	}
+	return res
+}
+
+func TestCollector(t *testing.T) {
+	lister := &gc.FakeLister{
+		ServiceList:          []inventory.Service{{Namespace: "default", Name: "live", ID: "uid-live"}},
+		PersistentVolumeList: []gc.PersistentVolume{{Name: "pv", VolumeID: "vol-pv"}},
+	}
+	t.Run("Orphans are reported after the grace period", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		expectInventory(mockSDK)
+		now := time.Now()
+		c := gc.NewCollector(mockSDK, lister, clusterID, gc.Options{
+			GracePeriod: time.Hour,
+			Clock:       func() time.Time { return now },
+		})
+		report, err := c.Run(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, report.Orphans)
+		assert.Equal(t, []string{"lb-gone", "sg-gone", "eipalloc-gone", "vol-gone"}, orphanIDs(report.Pending))
+
+		start := now
+		now = now.Add(2 * time.Hour)
+		report, err = c.Run(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, report.Pending)
+		require.Equal(t, []string{"lb-gone", "sg-gone", "eipalloc-gone", "vol-gone"}, orphanIDs(report.Orphans))
+		assert.Equal(t, start, report.Orphans[0].Since)
+		assert.Equal(t, "service default/gone not found", report.Orphans[0].Reason)
+		assert.Equal(t, "persistent volume pv-gone not found", report.Orphans[3].Reason)
+		assert.Nil(t, report.Plan)
+	})
+	t.Run("Orphans are deleted when enabled", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		expectInventory(mockSDK)
+		gomock.InOrder(
+			mockSDK.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Eq(osc.DeleteLoadBalancerRequest{LoadBalancerName: "lb-gone"})).
+				Return(&osc.DeleteLoadBalancerResponse{}, nil),
+			mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("eipalloc-gone")})).
+				Return(&osc.DeletePublicIpResponse{}, nil),
+			mockSDK.EXPECT().DeleteSecurityGroup(gomock.Any(), gomock.Eq(osc.DeleteSecurityGroupRequest{SecurityGroupId: ptr.To("sg-gone")})).
+				Return(&osc.DeleteSecurityGroupResponse{}, nil),
+			mockSDK.EXPECT().DeleteVolume(gomock.Any(), gomock.Eq(osc.DeleteVolumeRequest{VolumeId: "vol-gone"})).
+				Return(&osc.DeleteVolumeResponse{}, nil),
+		)
+		now := time.Now()
+		c := gc.NewCollector(mockSDK, lister, clusterID, gc.Options{
+			GracePeriod: time.Hour,
+			Delete:      true,
+			Clock:       func() time.Time { return now },
+		})
+		_, err := c.Run(t.Context())
+		require.NoError(t, err)
+		now = now.Add(2 * time.Hour)
+		report, err := c.Run(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, report.Plan)
+		assert.Len(t, report.Plan.Steps, 4)
+	})
+	t.Run("Owned volumes not created for a persistent volume are kept", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		expectInventory(mockSDK)
+		now := time.Now()
+		c := gc.NewCollector(mockSDK, &gc.FakeLister{}, clusterID, gc.Options{
+			GracePeriod: time.Hour,
+			Clock:       func() time.Time { return now },
+		})
+		_, err := c.Run(t.Context())
+		require.NoError(t, err)
+		now = now.Add(2 * time.Hour)
+		report, err := c.Run(t.Context())
+		require.NoError(t, err)
+		ids := orphanIDs(report.Orphans)
+		assert.Contains(t, ids, "vol-pv", "volumes of deleted persistent volumes are orphan")
+		assert.NotContains(t, ids, "vol-unmanaged")
+	})
+	t.Run("Lister errors are returned", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		expectInventory(mockSDK)
+		errList := errors.New("forbidden")
+		c := gc.NewCollector(mockSDK, &gc.FakeLister{Err: errList}, clusterID, gc.Options{})
+		_, err := c.Run(t.Context())
+		require.ErrorIs(t, err, errList)
+	})
+}
@@ -0,0 +1,53 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package gc
+
+import (
+	"context"
+
+	"github.com/outscale/goutils/k8s/inventory"
+)
+
+// PersistentVolume is a live persistent volume.
+type PersistentVolume struct {
+	Name string
+	// VolumeID is the ID of the backing volume (the CSI volume handle).
+	VolumeID string
+}
+
+// Lister lists the live Kubernetes objects.
+// It is usually backed by informer listers.
+type Lister interface {
+	// Services lists all services, ID being the service UID.
+	Services(ctx context.Context) ([]inventory.Service, error)
+	// Nodes lists the names of all nodes.
+	Nodes(ctx context.Context) ([]string, error)
+	// PersistentVolumes lists all persistent volumes.
+	PersistentVolumes(ctx context.Context) ([]PersistentVolume, error)
+}
+
+// FakeLister is a static Lister, for tests.
+type FakeLister struct {
+	ServiceList          []inventory.Service
+	NodeList             []string
+	PersistentVolumeList []PersistentVolume
+	// Err is returned by all calls, if set.
+	Err error
+}
+
+var _ Lister = (*FakeLister)(nil)
+
+func (l *FakeLister) Services(context.Context) ([]inventory.Service, error) {
+	return l.ServiceList, l.Err
+}
+
+func (l *FakeLister) Nodes(context.Context) ([]string, error) {
+	return l.NodeList, l.Err
+}
+
+func (l *FakeLister) PersistentVolumes(context.Context) ([]PersistentVolume, error) {
+	return l.PersistentVolumeList, l.Err
+}
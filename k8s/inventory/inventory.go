@@ -0,0 +1,384 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/outscale/goutils/k8s/role"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/ptr"
+	sdktags "github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// TagResourceTypeLoadBalancer is the type used for load balancers, not listed by ReadTags.
+	TagResourceTypeLoadBalancer osc.TagResourceType = "load-balancer"
+	// TagResourceTypeInternetService is the type used by ReadTags for internet services.
+	TagResourceTypeInternetService osc.TagResourceType = "internet-gateway"
+)
+
+// Service is the Kubernetes service owning a resource.
+type Service struct {
+	Namespace string
+	Name      string
+	ID        string
+}
+
+// Info is the ownership information of a resource, decoded from its tags.
+type Info struct {
+	ID        string
+	Type      osc.TagResourceType
+	Lifecycle tags.ResourceLifecycle
+	Roles     []role.Role
+	Service   *Service
+	NodeName  string
+	// PersistentVolume is the name of the persistent volume of a volume created by the CSI driver.
+	PersistentVolume string
+	Tags             []osc.ResourceTag
+}
+
+// NewInfo decodes the ownership information of a resource.
+func NewInfo(id string, typ osc.TagResourceType, clusterID string, t []osc.ResourceTag) Info {
+	info := Info{ID: id, Type: typ, Tags: t}
+	_, info.Lifecycle = tags.HasClusterID(t, clusterID)
+	info.Roles = tags.RolesOf(t)
+	name, hasName := tags.GetValue(t, tags.ServiceName)
+	ns, hasNS := tags.GetValue(t, tags.Namespace)
+	sid, hasID := tags.GetValue(t, tags.ServiceID)
+	if hasName || hasNS || hasID {
+		info.Service = &Service{Namespace: ns, Name: name, ID: sid}
+	}
+	info.NodeName = tags.Must(tags.GetValue(t, tags.VmNodeName))
+	if pv, found := tags.GetValue(t, tags.CSIVolumeName); found {
+		info.PersistentVolume = pv
+	} else {
+		info.PersistentVolume = tags.Must(tags.GetValue(t, tags.PVName))
+	}
+	return info
+}
+
+// Resource is a resource owned by a cluster.
+type Resource[T any] struct {
+	Info
+	Object T
+}
+
+// Inventory lists all resources tagged with a cluster ID.
+type Inventory struct {
+	ClusterID        string
+	Vms              []Resource[osc.Vm]
+	Volumes          []Resource[osc.Volume]
+	Snapshots        []Resource[osc.Snapshot]
+	Images           []Resource[osc.Image]
+	Nets             []Resource[osc.Net]
+	Subnets          []Resource[osc.Subnet]
+	SecurityGroups   []Resource[osc.SecurityGroup]
+	PublicIps        []Resource[osc.PublicIp]
+	Nics             []Resource[osc.Nic]
+	RouteTables      []Resource[osc.RouteTable]
+	NatServices      []Resource[osc.NatService]
+	NetAccessPoints  []Resource[osc.NetAccessPoint]
+	InternetServices []Resource[osc.InternetService]
+	LoadBalancers    []Resource[osc.LoadBalancer]
+	// Others lists the resources of types not having a typed field.
+	Others []Info
+}
+
+func infos[T any](rs []Resource[T]) []Info {
+	res := make([]Info, 0, len(rs))
+	for _, r := range rs {
+		res = append(res, r.Info)
+	}
+	return res
+}
+
+// All returns the ownership information of all resources.
+func (inv *Inventory) All() []Info {
+	return slices.Concat(
+		infos(inv.Vms), infos(inv.Volumes), infos(inv.Snapshots), infos(inv.Images),
+		infos(inv.Nets), infos(inv.Subnets), infos(inv.SecurityGroups), infos(inv.PublicIps),
+		infos(inv.Nics), infos(inv.RouteTables), infos(inv.NatServices), infos(inv.NetAccessPoints),
+		infos(inv.InternetServices), infos(inv.LoadBalancers), inv.Others,
+	)
+}
+
+// Group returns all resources, grouped by type and lifecycle.
+func (inv *Inventory) Group() map[osc.TagResourceType]map[tags.ResourceLifecycle][]Info {
+	res := map[osc.TagResourceType]map[tags.ResourceLifecycle][]Info{}
+	for _, info := range inv.All() {
+		if res[info.Type] == nil {
+			res[info.Type] = map[tags.ResourceLifecycle][]Info{}
+		}
+		res[info.Type][info.Lifecycle] = append(res[info.Type][info.Lifecycle], info)
+	}
+	return res
+}
+
+// Read lists all resources tagged with a cluster ID.
+// ReadTags is used to find tagged resources, and typed Read calls fetch the resources.
+// Load balancers are not listed by ReadTags and are all read.
+// NAT services and Net access points share the natgateway type, and are told apart by their ID prefix.
+func Read(ctx context.Context, client osc.ClientInterface, clusterID string) (*Inventory, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(4).Info("Fetching cluster inventory", "clusterID", clusterID)
+	ids, err := readTaggedIDs(ctx, client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	inv := &Inventory{ClusterID: clusterID}
+	natIDs, netAccessPointIDs := splitNetAccessPoints(ids[osc.TagResourceTypeNatServiceOrNetAccessPoint])
+	if inv.Vms, err = readResources(osc.TagResourceTypeVm, ids[osc.TagResourceTypeVm], clusterID,
+		func(ids []string, token *string) ([]osc.Vm, *string, error) {
+			resp, err := client.ReadVms(ctx, osc.ReadVmsRequest{Filters: &osc.FiltersVm{VmIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read vms: %w", err)
+			}
+			return ptr.From(resp.Vms), resp.NextPageToken, nil
+		}, func(r *osc.Vm) (string, []osc.ResourceTag) { return r.VmId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Volumes, err = readResources(osc.TagResourceTypeVolume, ids[osc.TagResourceTypeVolume], clusterID,
+		func(ids []string, token *string) ([]osc.Volume, *string, error) {
+			resp, err := client.ReadVolumes(ctx, osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{VolumeIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read volumes: %w", err)
+			}
+			return ptr.From(resp.Volumes), resp.NextPageToken, nil
+		}, func(r *osc.Volume) (string, []osc.ResourceTag) { return r.VolumeId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Snapshots, err = readResources(osc.TagResourceTypeSnapshot, ids[osc.TagResourceTypeSnapshot], clusterID,
+		func(ids []string, token *string) ([]osc.Snapshot, *string, error) {
+			resp, err := client.ReadSnapshots(ctx, osc.ReadSnapshotsRequest{Filters: &osc.FiltersSnapshot{SnapshotIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read snapshots: %w", err)
+			}
+			return ptr.From(resp.Snapshots), resp.NextPageToken, nil
+		}, func(r *osc.Snapshot) (string, []osc.ResourceTag) { return r.SnapshotId, ptr.From(r.Tags) }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Images, err = readResources(osc.TagResourceTypeImage, ids[osc.TagResourceTypeImage], clusterID,
+		func(ids []string, token *string) ([]osc.Image, *string, error) {
+			resp, err := client.ReadImages(ctx, osc.ReadImagesRequest{Filters: &osc.FiltersImage{ImageIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read images: %w", err)
+			}
+			return ptr.From(resp.Images), resp.NextPageToken, nil
+		}, func(r *osc.Image) (string, []osc.ResourceTag) { return r.ImageId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Nets, err = readResources(osc.TagResourceTypeNet, ids[osc.TagResourceTypeNet], clusterID,
+		func(ids []string, token *string) ([]osc.Net, *string, error) {
+			resp, err := client.ReadNets(ctx, osc.ReadNetsRequest{Filters: &osc.FiltersNet{NetIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read nets: %w", err)
+			}
+			return ptr.From(resp.Nets), resp.NextPageToken, nil
+		}, func(r *osc.Net) (string, []osc.ResourceTag) { return r.NetId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Subnets, err = readResources(osc.TagResourceTypeSubnet, ids[osc.TagResourceTypeSubnet], clusterID,
+		func(ids []string, token *string) ([]osc.Subnet, *string, error) {
+			resp, err := client.ReadSubnets(ctx, osc.ReadSubnetsRequest{Filters: &osc.FiltersSubnet{SubnetIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read subnets: %w", err)
+			}
+			return ptr.From(resp.Subnets), resp.NextPageToken, nil
+		}, func(r *osc.Subnet) (string, []osc.ResourceTag) { return r.SubnetId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.SecurityGroups, err = readResources(osc.TagResourceTypeSecurityGroup, ids[osc.TagResourceTypeSecurityGroup], clusterID,
+		func(ids []string, token *string) ([]osc.SecurityGroup, *string, error) {
+			resp, err := client.ReadSecurityGroups(ctx, osc.ReadSecurityGroupsRequest{Filters: &osc.FiltersSecurityGroup{SecurityGroupIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read security groups: %w", err)
+			}
+			return ptr.From(resp.SecurityGroups), resp.NextPageToken, nil
+		}, func(r *osc.SecurityGroup) (string, []osc.ResourceTag) { return r.SecurityGroupId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.PublicIps, err = readResources(osc.TagResourceTypePublicIp, ids[osc.TagResourceTypePublicIp], clusterID,
+		func(ids []string, token *string) ([]osc.PublicIp, *string, error) {
+			resp, err := client.ReadPublicIps(ctx, osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{PublicIpIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read public ips: %w", err)
+			}
+			return ptr.From(resp.PublicIps), resp.NextPageToken, nil
+		}, func(r *osc.PublicIp) (string, []osc.ResourceTag) { return r.PublicIpId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Nics, err = readResources(osc.TagResourceTypeNic, ids[osc.TagResourceTypeNic], clusterID,
+		func(ids []string, token *string) ([]osc.Nic, *string, error) {
+			resp, err := client.ReadNics(ctx, osc.ReadNicsRequest{Filters: &osc.FiltersNic{NicIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read nics: %w", err)
+			}
+			return ptr.From(resp.Nics), resp.NextPageToken, nil
+		}, func(r *osc.Nic) (string, []osc.ResourceTag) { return r.NicId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.RouteTables, err = readResources(osc.TagResourceTypeRouteTable, ids[osc.TagResourceTypeRouteTable], clusterID,
+		func(ids []string, token *string) ([]osc.RouteTable, *string, error) {
+			resp, err := client.ReadRouteTables(ctx, osc.ReadRouteTablesRequest{Filters: &osc.FiltersRouteTable{RouteTableIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read route tables: %w", err)
+			}
+			return ptr.From(resp.RouteTables), resp.NextPageToken, nil
+		}, func(r *osc.RouteTable) (string, []osc.ResourceTag) { return r.RouteTableId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.NatServices, err = readResources(osc.TagResourceTypeNatServiceOrNetAccessPoint, natIDs, clusterID,
+		func(ids []string, token *string) ([]osc.NatService, *string, error) {
+			resp, err := client.ReadNatServices(ctx, osc.ReadNatServicesRequest{Filters: &osc.FiltersNatService{NatServiceIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read nat services: %w", err)
+			}
+			return ptr.From(resp.NatServices), resp.NextPageToken, nil
+		}, func(r *osc.NatService) (string, []osc.ResourceTag) { return r.NatServiceId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.NetAccessPoints, err = readResources(osc.TagResourceTypeNatServiceOrNetAccessPoint, netAccessPointIDs, clusterID,
+		func(ids []string, token *string) ([]osc.NetAccessPoint, *string, error) {
+			resp, err := client.ReadNetAccessPoints(ctx, osc.ReadNetAccessPointsRequest{Filters: &osc.FiltersNetAccessPoint{NetAccessPointIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read net access points: %w", err)
+			}
+			return ptr.From(resp.NetAccessPoints), resp.NextPageToken, nil
+		}, func(r *osc.NetAccessPoint) (string, []osc.ResourceTag) { return r.NetAccessPointId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.InternetServices, err = readResources(TagResourceTypeInternetService, ids[TagResourceTypeInternetService], clusterID,
+		func(ids []string, token *string) ([]osc.InternetService, *string, error) {
+			resp, err := client.ReadInternetServices(ctx, osc.ReadInternetServicesRequest{Filters: &osc.FiltersInternetService{InternetServiceIds: &ids}, NextPageToken: token})
+			if err != nil {
+				return nil, nil, fmt.Errorf("read internet services: %w", err)
+			}
+			return ptr.From(resp.InternetServices), resp.NextPageToken, nil
+		}, func(r *osc.InternetService) (string, []osc.ResourceTag) { return r.InternetServiceId, r.Tags }); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.LoadBalancers, err = readLoadBalancers(ctx, client, clusterID); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	if inv.Others, err = readOthers(ctx, client, ids, clusterID); err != nil {
+		return nil, fmt.Errorf("inventory: %w", err)
+	}
+	logger.V(4).Info("Cluster inventory fetched", "clusterID", clusterID, "count", len(inv.All()))
+	return inv, nil
+}
+
+// typedResources lists the types having a typed field in Inventory.
+var typedResources = []osc.TagResourceType{
+	osc.TagResourceTypeVm, osc.TagResourceTypeVolume, osc.TagResourceTypeSnapshot, osc.TagResourceTypeImage,
+	osc.TagResourceTypeNet, osc.TagResourceTypeSubnet, osc.TagResourceTypeSecurityGroup, osc.TagResourceTypePublicIp,
+	osc.TagResourceTypeNic, osc.TagResourceTypeRouteTable, osc.TagResourceTypeNatServiceOrNetAccessPoint,
+	TagResourceTypeInternetService,
+}
+
+func readTaggedIDs(ctx context.Context, client osc.ClientInterface, clusterID string) (map[osc.TagResourceType][]string, error) {
+	ids := map[osc.TagResourceType][]string{}
+	req := osc.ReadTagsRequest{
+		Filters: &osc.FiltersTag{Keys: &[]string{tags.ClusterIDKey(clusterID)}},
+	}
+	for {
+		resp, err := client.ReadTags(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("read tags: %w", err)
+		}
+		for _, t := range ptr.From(resp.Tags) {
+			ids[t.ResourceType] = append(ids[t.ResourceType], t.ResourceId)
+		}
+		if ptr.From(resp.NextPageToken) == "" {
+			return ids, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// netAccessPointPrefix is the ID prefix of Net access points, sharing the natgateway tag resource type with NAT services.
+const netAccessPointPrefix = "vpce-"
+
+// splitNetAccessPoints splits the IDs tagged as natgateway into NAT service and Net access point IDs.
+func splitNetAccessPoints(ids []string) (natIDs, netAccessPointIDs []string) {
+	for _, id := range ids {
+		if strings.HasPrefix(id, netAccessPointPrefix) {
+			netAccessPointIDs = append(netAccessPointIDs, id)
+		} else {
+			natIDs = append(natIDs, id)
+		}
+	}
+	return natIDs, netAccessPointIDs
+}
+
+// readResources reads resources by ID, following pagination. read reads a page of resources, and decode returns the
+// ID and the tags of a resource.
+func readResources[T any](typ osc.TagResourceType, ids []string, clusterID string,
+	read func(ids []string, token *string) ([]T, *string, error),
+	decode func(r *T) (string, []osc.ResourceTag),
+) ([]Resource[T], error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var (
+		res   []Resource[T]
+		token *string
+	)
+	for {
+		page, next, err := read(ids, token)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page {
+			id, t := decode(&page[i])
+			res = append(res, Resource[T]{Info: NewInfo(id, typ, clusterID, t), Object: page[i]})
+		}
+		if ptr.From(next) == "" {
+			return res, nil
+		}
+		token = next
+	}
+}
+
+func readLoadBalancers(ctx context.Context, client osc.ClientInterface, clusterID string) ([]Resource[osc.LoadBalancer], error) {
+	resp, err := client.ReadLoadBalancers(ctx, osc.ReadLoadBalancersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("read load balancers: %w", err)
+	}
+	var res []Resource[osc.LoadBalancer]
+	for _, lb := range ptr.From(resp.LoadBalancers) {
+		if found, _ := tags.HasClusterID(lb.Tags, clusterID); found {
+			res = append(res, Resource[osc.LoadBalancer]{
+				Info:   NewInfo(lb.LoadBalancerName, TagResourceTypeLoadBalancer, clusterID, lb.Tags),
+				Object: lb,
+			})
+		}
+	}
+	return res, nil
+}
+
+func readOthers(ctx context.Context, client osc.ClientInterface, ids map[osc.TagResourceType][]string, clusterID string) ([]Info, error) {
+	var res []Info
+	for _, typ := range slices.Sorted(maps.Keys(ids)) {
+		if slices.Contains(typedResources, typ) {
+			continue
+		}
+		sets, err := sdktags.Read(ctx, client, ids[typ])
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids[typ] {
+			res = append(res, NewInfo(id, typ, clusterID, sets[id].Slice()))
+		}
+	}
+	return res, nil
+}
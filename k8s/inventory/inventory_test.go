@@ -0,0 +1,117 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/role"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const clusterID = "37286b54-bb4b-46c8-ac8a-37621f1e7123"
+
+func TestRead(t *testing.T) {
+	owned := osc.ResourceTag{Key: tags.ClusterIDKey(clusterID), Value: string(tags.ResourceLifecycleOwned)}
+	shared := osc.ResourceTag{Key: tags.ClusterIDKey(clusterID), Value: string(tags.ResourceLifecycleShared)}
+
+	mockCtrl := gomock.NewController(t)
+	mockSDK := mocks_osc.NewMockClient(mockCtrl)
+	mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Eq(osc.ReadTagsRequest{
+		Filters: &osc.FiltersTag{Keys: &[]string{tags.ClusterIDKey(clusterID)}},
+	})).Return(&osc.ReadTagsResponse{Tags: &[]osc.Tag{
+		{ResourceId: "i-foo", ResourceType: osc.TagResourceTypeVm, Key: owned.Key, Value: owned.Value},
+		{ResourceId: "subnet-foo", ResourceType: osc.TagResourceTypeSubnet, Key: shared.Key, Value: shared.Value},
+		{ResourceId: "key-foo", ResourceType: osc.TagResourceTypeKeypair, Key: owned.Key, Value: owned.Value},
+	}}, nil)
+	mockSDK.EXPECT().ReadVms(gomock.Any(), gomock.Eq(osc.ReadVmsRequest{
+		Filters: &osc.FiltersVm{VmIds: &[]string{"i-foo"}},
+	})).Return(&osc.ReadVmsResponse{Vms: &[]osc.Vm{
+		{VmId: "i-foo", Tags: []osc.ResourceTag{owned, {Key: tags.RoleKey(role.Worker)}, {Key: tags.VmNodeName, Value: "node-foo"}}},
+	}}, nil)
+	mockSDK.EXPECT().ReadSubnets(gomock.Any(), gomock.Eq(osc.ReadSubnetsRequest{
+		Filters: &osc.FiltersSubnet{SubnetIds: &[]string{"subnet-foo"}},
+	})).Return(&osc.ReadSubnetsResponse{Subnets: &[]osc.Subnet{
+		{SubnetId: "subnet-foo", Tags: []osc.ResourceTag{shared, {Key: tags.RoleKey(role.Worker)}, {Key: tags.RoleKey(role.ControlPlane)}}},
+	}}, nil)
+	mockSDK.EXPECT().ReadLoadBalancers(gomock.Any(), gomock.Any()).Return(&osc.ReadLoadBalancersResponse{LoadBalancers: &[]osc.LoadBalancer{
+		{LoadBalancerName: "lb-foo", Tags: []osc.ResourceTag{owned, {Key: tags.ServiceName, Value: "svc"}, {Key: tags.Namespace, Value: "ns"}}},
+		{LoadBalancerName: "lb-bar"},
+	}}, nil)
+	mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Eq(osc.ReadTagsRequest{
+		Filters: &osc.FiltersTag{ResourceIds: &[]string{"key-foo"}},
+	})).Return(&osc.ReadTagsResponse{Tags: &[]osc.Tag{
+		{ResourceId: "key-foo", ResourceType: osc.TagResourceTypeKeypair, Key: owned.Key, Value: owned.Value},
+	}}, nil)
+
+	inv, err := inventory.Read(t.Context(), mockSDK, clusterID)
+	require.NoError(t, err)
+	require.Len(t, inv.Vms, 1)
+	assert.Equal(t, "i-foo", inv.Vms[0].Object.VmId)
+	assert.Equal(t, []role.Role{role.Worker}, inv.Vms[0].Roles)
+	assert.Equal(t, "node-foo", inv.Vms[0].NodeName)
+	require.Len(t, inv.Subnets, 1)
+	assert.Equal(t, tags.ResourceLifecycleShared, inv.Subnets[0].Lifecycle)
+	assert.Equal(t, []role.Role{role.ControlPlane, role.Worker}, inv.Subnets[0].Roles)
+	require.Len(t, inv.LoadBalancers, 1)
+	assert.Equal(t, &inventory.Service{Namespace: "ns", Name: "svc"}, inv.LoadBalancers[0].Service)
+	require.Len(t, inv.Others, 1)
+	assert.Equal(t, "key-foo", inv.Others[0].ID)
+
+	groups := inv.Group()
+	assert.Len(t, groups, 4)
+	assert.Len(t, groups[osc.TagResourceTypeVm][tags.ResourceLifecycleOwned], 1)
+	assert.Len(t, groups[osc.TagResourceTypeSubnet][tags.ResourceLifecycleShared], 1)
+	assert.Len(t, groups[inventory.TagResourceTypeLoadBalancer][tags.ResourceLifecycleOwned], 1)
+	assert.Len(t, groups[osc.TagResourceTypeKeypair][tags.ResourceLifecycleOwned], 1)
+}
+
+func TestRead_NetAccessPoints(t *testing.T) {
+	owned := osc.ResourceTag{Key: tags.ClusterIDKey(clusterID), Value: string(tags.ResourceLifecycleOwned)}
+
+	mockCtrl := gomock.NewController(t)
+	mockSDK := mocks_osc.NewMockClient(mockCtrl)
+	mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Any()).Return(&osc.ReadTagsResponse{Tags: &[]osc.Tag{
+		{ResourceId: "nat-foo", ResourceType: osc.TagResourceTypeNatServiceOrNetAccessPoint, Key: owned.Key, Value: owned.Value},
+		{ResourceId: "vpce-foo", ResourceType: osc.TagResourceTypeNatServiceOrNetAccessPoint, Key: owned.Key, Value: owned.Value},
+		{ResourceId: "nat-bar", ResourceType: osc.TagResourceTypeNatServiceOrNetAccessPoint, Key: owned.Key, Value: owned.Value},
+	}}, nil)
+	mockSDK.EXPECT().ReadNatServices(gomock.Any(), gomock.Eq(osc.ReadNatServicesRequest{
+		Filters: &osc.FiltersNatService{NatServiceIds: &[]string{"nat-foo", "nat-bar"}},
+	})).Return(&osc.ReadNatServicesResponse{
+		NatServices:   &[]osc.NatService{{NatServiceId: "nat-foo", Tags: []osc.ResourceTag{owned}}},
+		NextPageToken: ptr.To("page-2"),
+	}, nil)
+	mockSDK.EXPECT().ReadNatServices(gomock.Any(), gomock.Eq(osc.ReadNatServicesRequest{
+		Filters:       &osc.FiltersNatService{NatServiceIds: &[]string{"nat-foo", "nat-bar"}},
+		NextPageToken: ptr.To("page-2"),
+	})).Return(&osc.ReadNatServicesResponse{
+		NatServices: &[]osc.NatService{{NatServiceId: "nat-bar", Tags: []osc.ResourceTag{owned}}},
+	}, nil)
+	mockSDK.EXPECT().ReadNetAccessPoints(gomock.Any(), gomock.Eq(osc.ReadNetAccessPointsRequest{
+		Filters: &osc.FiltersNetAccessPoint{NetAccessPointIds: &[]string{"vpce-foo"}},
+	})).Return(&osc.ReadNetAccessPointsResponse{
+		NetAccessPoints: &[]osc.NetAccessPoint{{NetAccessPointId: "vpce-foo", Tags: []osc.ResourceTag{owned}}},
+	}, nil)
+	mockSDK.EXPECT().ReadLoadBalancers(gomock.Any(), gomock.Any()).Return(&osc.ReadLoadBalancersResponse{}, nil)
+
+	inv, err := inventory.Read(t.Context(), mockSDK, clusterID)
+	require.NoError(t, err)
+	require.Len(t, inv.NatServices, 2)
+	assert.Equal(t, "nat-foo", inv.NatServices[0].ID)
+	assert.Equal(t, "nat-bar", inv.NatServices[1].ID)
+	assert.Equal(t, tags.ResourceLifecycleOwned, inv.NatServices[1].Lifecycle)
+	require.Len(t, inv.NetAccessPoints, 1)
+	assert.Equal(t, "vpce-foo", inv.NetAccessPoints[0].Object.NetAccessPointId)
+	assert.Len(t, inv.All(), 3)
+}
@@ -8,15 +8,30 @@ package log
 import (
 	"context"
 
+	sdklog "github.com/outscale/goutils/sdk/log"
 	"k8s.io/klog/v2"
 )
 
-type Logger struct{}
+// Logger is a sdk/log logger, writing to the klog logger of the context.
+type Logger struct {
+	level int
+	kv    []any
+}
+
+var _ sdklog.Logger = Logger{}
+
+func (l Logger) Info(ctx context.Context, msg string, kv ...any) {
+	klog.FromContext(ctx).WithCallDepth(1).V(l.level).WithValues(l.kv...).Info(msg, kv...)
+}
+
+func (l Logger) Error(ctx context.Context, err error, msg string, kv ...any) {
+	klog.FromContext(ctx).WithCallDepth(1).WithValues(l.kv...).Error(err, msg, kv...)
+}
 
-func (Logger) Info(ctx context.Context, msg string, kv ...any) {
-	klog.FromContext(ctx).V(5).Info(msg, kv...)
+func (l Logger) V(level int) sdklog.Logger {
+	return Logger{level: l.level + level, kv: l.kv}
 }
 
-func (Logger) Error(ctx context.Context, err error, msg string, kv ...any) {
-	klog.FromContext(ctx).V(3).Error(err, msg, kv...)
+func (l Logger) With(kv ...any) sdklog.Logger {
+	return Logger{level: l.level, kv: append(l.kv[:len(l.kv):len(l.kv)], kv...)}
 }
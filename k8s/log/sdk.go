@@ -8,31 +8,66 @@ package log
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"path"
-	"strings"
 	"time"
 
+	sdklog "github.com/outscale/goutils/sdk/log"
 	"k8s.io/klog/v2"
 )
 
-const maxResponseLength = 500
+// DefaultMaxPayloadLength is the default max length of logged payloads.
+const DefaultMaxPayloadLength = 500
 
-func clean(buf []byte) string {
-	return strings.ReplaceAll(string(buf), `"`, ``)
+// OAPILogger logs OAPI calls. Secret fields of request and response bodies are redacted.
+//
+// Requests and responses are logged with the following keys:
+//   - OAPI: the call name,
+//   - http_status: the HTTP status code (responses only),
+//   - duration: the call duration (responses only),
+//   - request_id: the OAPI request ID (responses only),
+//   - error_code/error_type: the first OAPI error (error responses only),
+//   - payload: the redacted JSON body, truncated to MaxPayloadLength.
+type OAPILogger struct {
+	// Redactor redacts secret fields. sdklog.DefaultRedactor is used if nil.
+	Redactor *sdklog.Redactor
+	// MaxPayloadLength is the max length of logged payloads. Defaults to DefaultMaxPayloadLength.
+	MaxPayloadLength int
+	// JSONPayload logs payloads as raw JSON values instead of strings, to be embedded in the output of
+	// JSON log formats. Truncated payloads are still logged as strings.
+	JSONPayload bool
 }
 
-func truncatedBody(body string) string {
-	str := []rune(body)
-	if len(str) > maxResponseLength {
-		return string(str[:maxResponseLength/2]) + " [truncated] " + string(str[len(str)-maxResponseLength/2:])
+func (l OAPILogger) redact(call string, body []byte) []byte {
+	if l.Redactor == nil {
+		return sdklog.DefaultRedactor.Redact(call, body)
 	}
-	return string(str)
+	return l.Redactor.Redact(call, body)
 }
 
-type OAPILogger struct{}
+// payload returns the key/values of a redacted and size bounded payload.
+func (l OAPILogger) payload(call string, body []byte) []any {
+	body = l.redact(call, body)
+	maxLength := l.MaxPayloadLength
+	if maxLength <= 0 {
+		maxLength = DefaultMaxPayloadLength
+	}
+	str := []rune(string(body))
+	switch {
+	case len(str) > maxLength:
+		return []any{
+			"payload", string(str[:maxLength/2]) + " [truncated] " + string(str[len(str)-maxLength/2:]),
+			"payload_size", len(body),
+		}
+	case l.JSONPayload && json.Valid(body):
+		return []any{"payload", json.RawMessage(body)}
+	default:
+		return []any{"payload", string(body)}
+	}
+}
 
 func callName(r *http.Request) string {
 	return path.Base(r.URL.Path)
@@ -63,7 +98,8 @@ func (l OAPILogger) RequestHttp(ctx context.Context, req *http.Request) {
 		l.Error(ctx, fmt.Errorf("log request: %w", err))
 		return
 	}
-	logger.Info("OAPI request: "+clean(body), "OAPI", callName(req))
+	call := callName(req)
+	logger.Info("OAPI request", append([]any{"OAPI", call}, l.payload(call, body)...)...)
 }
 
 func responseBody(httpResp *http.Response) ([]byte, error) {
@@ -75,6 +111,17 @@ func responseBody(httpResp *http.Response) ([]byte, error) {
 	return body, nil
 }
 
+// responseInfo is the part of OAPI responses that is always logged.
+type responseInfo struct {
+	ResponseContext struct {
+		RequestId string
+	}
+	Errors []struct {
+		Code string
+		Type string
+	}
+}
+
 func (l OAPILogger) ResponseHttp(ctx context.Context, resp *http.Response, d time.Duration) {
 	logger := klog.FromContext(ctx).WithCallDepth(1)
 	call := callName(resp.Request)
@@ -86,12 +133,21 @@ func (l OAPILogger) ResponseHttp(ctx context.Context, resp *http.Response, d tim
 		l.Error(ctx, fmt.Errorf("log response: %w", err))
 		return
 	}
-	sbody := truncatedBody(clean(body))
+	kv := []any{"OAPI", call, "http_status", resp.StatusCode, "duration", d}
+	var info responseInfo
+	_ = json.Unmarshal(body, &info)
+	if info.ResponseContext.RequestId != "" {
+		kv = append(kv, "request_id", info.ResponseContext.RequestId)
+	}
+	if len(info.Errors) > 0 {
+		kv = append(kv, "error_code", info.Errors[0].Code, "error_type", info.Errors[0].Type)
+	}
+	kv = append(kv, l.payload(call, body)...)
 	switch {
 	case resp.StatusCode > 299:
-		logger.V(3).Info("OAPI error response: "+sbody, "OAPI", call, "http_status", resp.Status, "duration", d)
+		logger.V(3).Info("OAPI error response", kv...)
 	case logger.V(5).Enabled(): // no error
-		logger.Info("OAPI response: "+sbody, "OAPI", call, "duration", d)
+		logger.Info("OAPI response", kv...)
 	}
 }
 
@@ -0,0 +1,54 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package log_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+)
+
+func TestOAPILogger(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := klog.NewContext(t.Context(), textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(5), textlogger.Output(&buf))))
+	t.Run("Secrets are redacted before truncation", func(t *testing.T) {
+		buf.Reset()
+		body := `{"Keypair":{"KeypairName":"foo","PrivateKey":"` + strings.Repeat("x", 1000) + `"}}`
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    &http.Request{URL: &url.URL{Path: "/api/v1/CreateKeypair"}},
+		}
+		log.OAPILogger{}.ResponseHttp(ctx, resp, time.Second)
+		assert.Contains(t, buf.String(), `\"PrivateKey\":\"[redacted]\"`)
+		assert.NotContains(t, buf.String(), "xxx")
+		assert.NotContains(t, buf.String(), "[truncated]")
+	})
+	t.Run("Responses are logged with structured values", func(t *testing.T) {
+		buf.Reset()
+		body := `{"Errors":[{"Code":"10001","Type":"TooManyRequests"}],"ResponseContext":{"RequestId":"req-foo"}}`
+		resp := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    &http.Request{URL: &url.URL{Path: "/api/v1/ReadVms"}},
+		}
+		log.OAPILogger{JSONPayload: true}.ResponseHttp(ctx, resp, time.Second)
+		assert.Contains(t, buf.String(), `"OAPI error response" OAPI="ReadVms" http_status=503 duration="1s" request_id="req-foo" error_code="10001" error_type="TooManyRequests"`)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(b))
+	})
+}
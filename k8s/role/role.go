@@ -5,6 +5,13 @@ SPDX-License-Identifier: BSD-3-Clause
 */
 package role
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
 // Role defines a role, used to define roles of subnets/SGs.
 type Role string
 
@@ -24,3 +31,31 @@ const (
 	// InternalService is used for internal service LBs.
 	InternalService Role = "service.internal"
 )
+
+// All lists all known roles.
+var All = []Role{ControlPlane, Worker, LoadBalancer, Bastion, Nat, Service, InternalService}
+
+// ErrUnknownRole is returned when parsing an unknown role.
+var ErrUnknownRole = errors.New("unknown role")
+
+// Valid checks if a role is a known role.
+func (r Role) Valid() bool {
+	return slices.Contains(All, r)
+}
+
+// Validate returns an error if a role is unknown.
+func (r Role) Validate() error {
+	if !r.Valid() {
+		return fmt.Errorf("%w %q, valid roles are %s", ErrUnknownRole, string(r), NewSet(All...))
+	}
+	return nil
+}
+
+// Parse parses a role.
+func Parse(s string) (Role, error) {
+	r := Role(strings.TrimSpace(s))
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	return r, nil
+}
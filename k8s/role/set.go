@@ -0,0 +1,99 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package role
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Set is a set of roles. Sets built by NewSet or ParseSet are sorted, and literals may be unsorted.
+//
+// Set implements pflag.Value, and can be used as a comma-separated list flag:
+//
+//	roles := role.NewSet(role.Service)
+//	fs.Var(&roles, "lb-roles", "Roles of load balancer subnets")
+type Set []Role
+
+var _ pflag.Value = (*Set)(nil)
+
+// NewSet builds a set from roles. Duplicates are removed.
+func NewSet(roles ...Role) Set {
+	s := slices.Clone(roles)
+	slices.Sort(s)
+	return slices.Compact(s)
+}
+
+// ParseSet parses a comma-separated list of roles. Empty items are ignored.
+func ParseSet(s string) (Set, error) {
+	var (
+		roles []Role
+		errs  []error
+	)
+	for item := range strings.SplitSeq(s, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		r, err := Parse(item)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		roles = append(roles, r)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NewSet(roles...), nil
+}
+
+// Has checks if a set contains a role.
+func (s Set) Has(r Role) bool {
+	return slices.Contains(s, r)
+}
+
+// HasAny checks if a set contains any of the roles.
+func (s Set) HasAny(roles ...Role) bool {
+	return slices.ContainsFunc(roles, s.Has)
+}
+
+// Validate checks that all roles of a set are known.
+func (s Set) Validate() error {
+	var errs []error
+	for _, r := range s {
+		if err := r.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String returns the comma-separated list of roles.
+func (s Set) String() string {
+	strs := make([]string, 0, len(s))
+	for _, r := range s {
+		strs = append(strs, string(r))
+	}
+	return strings.Join(strs, ",")
+}
+
+// Set replaces the set by a parsed comma-separated list of roles.
+func (s *Set) Set(v string) error {
+	roles, err := ParseSet(v)
+	if err != nil {
+		return err
+	}
+	*s = roles
+	return nil
+}
+
+// Type returns the flag type.
+func (s *Set) Type() string {
+	return "roles"
+}
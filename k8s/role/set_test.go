@@ -0,0 +1,52 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package role_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/k8s/role"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	r, err := role.Parse(" service.internal")
+	require.NoError(t, err)
+	assert.Equal(t, role.InternalService, r)
+	_, err = role.Parse("wroker")
+	require.ErrorIs(t, err, role.ErrUnknownRole)
+	assert.Equal(t, `unknown role "wroker", valid roles are bastion,controlplane,loadbalancer,nat,service,service.internal,worker`, err.Error())
+}
+
+func TestParseSet(t *testing.T) {
+	s, err := role.ParseSet("worker,controlplane,, worker")
+	require.NoError(t, err)
+	assert.Equal(t, role.Set{role.ControlPlane, role.Worker}, s)
+	assert.True(t, s.Has(role.Worker))
+	assert.False(t, s.Has(role.Bastion))
+	assert.True(t, s.HasAny(role.Bastion, role.ControlPlane))
+	assert.Equal(t, "controlplane,worker", s.String())
+	assert.True(t, role.Set{role.Worker, role.ControlPlane, role.Bastion}.Has(role.ControlPlane), "unsorted literals are supported")
+
+	_, err = role.ParseSet("service,foo,bar")
+	require.ErrorIs(t, err, role.ErrUnknownRole)
+
+	require.Error(t, role.NewSet(role.Worker, "foo").Validate())
+	require.NoError(t, role.NewSet(role.All...).Validate())
+}
+
+func TestSetFlag(t *testing.T) {
+	roles := role.NewSet(role.Service)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(&roles, "lb-roles", "Roles of load balancer subnets")
+	assert.Equal(t, "service", fs.Lookup("lb-roles").DefValue)
+
+	require.NoError(t, fs.Parse([]string{"--lb-roles=service,service.internal"}))
+	assert.Equal(t, role.Set{role.Service, role.InternalService}, roles)
+	require.Error(t, fs.Parse([]string{"--lb-roles=servcie"}))
+}
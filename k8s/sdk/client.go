@@ -12,6 +12,7 @@ import (
 
 	"dario.cat/mergo"
 	"github.com/outscale/goutils/k8s/log"
+	sdklog "github.com/outscale/goutils/sdk/log"
 	"github.com/outscale/goutils/sdk/metadata"
 	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
 	"github.com/outscale/osc-sdk-go/v3/pkg/options"
@@ -30,6 +31,12 @@ func NewSDKClient(ctx context.Context, ua string, opts ...Options) (*profile.Pro
 		return nil, nil, errors.New("OSC_ACCESS_KEY/OSC_SECRET_KEY are required")
 	}
 	lg := log.OAPILogger{}
+	if len(opts) > 0 {
+		if len(opts[0].RedactFields) > 0 {
+			lg.Redactor = sdklog.DefaultRedactor.With(opts[0].RedactFields...)
+		}
+		lg.JSONPayload = opts[0].LogJSONPayload
+	}
 	copts := []middleware.MiddlewareChainOption{options.WithUseragent(ua), options.WithLogging(lg)}
 	if len(opts) > 0 {
 		opt := opts[0]
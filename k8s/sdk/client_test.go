@@ -0,0 +1,79 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package sdk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/sdk"
+	"github.com/outscale/goutils/sdk/cassette"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/oapitest"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSDKClient(t *testing.T) {
+	opts := sdk.Options{RetryCount: 3, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}
+	t.Run("The client is built from env vars, and credentials are checked", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Setenv(t)
+		prof, client, err := sdk.NewSDKClient(t.Context(), "goutils-test", opts)
+		require.NoError(t, err)
+		assert.Equal(t, oapitest.Region, prof.Region)
+		_, err = client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		reqs := srv.Requests()
+		require.Len(t, reqs, 2)
+		assert.Equal(t, "ReadVms", reqs[0].Call, "credentials are checked")
+		assert.Equal(t, "goutils-test", reqs[1].Header.Get("User-Agent"))
+	})
+	t.Run("Throttled calls are retried, with the configured retry count", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Setenv(t)
+		_, client, err := sdk.NewSDKClient(t.Context(), "goutils-test", opts)
+		require.NoError(t, err)
+		srv.Throttle("ReadVolumes", 3)
+		_, err = client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 4, srv.Calls("ReadVolumes"))
+		srv.Throttle("ReadVolumes", 4)
+		_, err = client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrThrottled))
+	})
+	t.Run("Calls are not retried without retry count", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Setenv(t)
+		srv.Throttle("ReadVms", 1)
+		_, _, err := sdk.NewSDKClient(t.Context(), "goutils-test", sdk.Options{})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrThrottled))
+		assert.Equal(t, 1, srv.Calls("ReadVms"))
+	})
+	t.Run("Calls are recorded and replayed with cassettes", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Setenv(t)
+		c := cassette.New()
+		_, client, err := sdk.NewSDKClient(t.Context(), "goutils-test", sdk.Options{
+			Middlewares: []middleware.MiddlewareChainOption{cassette.Record(c, "goutils-test")},
+		})
+		require.NoError(t, err)
+		_, err = client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		srv.Close()
+
+		_, client, err = sdk.NewSDKClient(t.Context(), "goutils-test", sdk.Options{
+			Middlewares: []middleware.MiddlewareChainOption{cassette.Replay(c, "goutils-test")},
+		})
+		require.NoError(t, err)
+		_, err = client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, c.Unplayed())
+	})
+}
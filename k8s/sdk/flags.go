@@ -7,9 +7,12 @@ package sdk
 import (
 	"time"
 
+	"github.com/outscale/goutils/sdk/tracing"
 	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware/retry"
 	"github.com/outscale/osc-sdk-go/v3/pkg/options"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -28,6 +31,14 @@ type Options struct {
 	RateLimit                  int
 	RetryWaitMin, RetryWaitMax time.Duration
 	RetryCount                 int
+	// RedactFields lists additional fields redacted from logged OAPI bodies.
+	RedactFields []string
+	// LogJSONPayload logs OAPI payloads as raw JSON values, for JSON log formats.
+	LogJSONPayload bool
+	// TracerProvider enables the tracing of OAPI calls. A span is created per call, including all retries.
+	TracerProvider trace.TracerProvider
+	// Middlewares are additional middleware options, applied after all others (e.g. cassette.Record).
+	Middlewares []middleware.MiddlewareChainOption
 }
 
 // AddFlags adds flags for SDK options to a flag set.
@@ -36,14 +47,24 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.RetryWaitMin, "oapi-retry-wait-min", DefaultRetryWaitMin, "Minimum wait between retries")
 	fs.DurationVar(&o.RetryWaitMax, "oapi-retry-wait-max", DefaultRetryWaitMax, "Maximum wait between retries")
 	fs.IntVar(&o.RetryCount, "oapi-retry-count", DefaultRetryCount, "Maximum number of retries")
+	fs.StringSliceVar(&o.RedactFields, "oapi-log-redact-fields", nil, "Additional fields to redact from logged OAPI requests and responses")
+	fs.BoolVar(&o.LogJSONPayload, "oapi-log-json-payload", false, "Log OAPI payloads as JSON values instead of strings (for the JSON log format)")
 }
 
 func (o *Options) middleware() []middleware.MiddlewareChainOption {
 	opts := []middleware.MiddlewareChainOption{options.WithRatelimit(o.RateLimit)}
-	if o.RetryCount > 0 {
+	switch {
+	case o.TracerProvider != nil:
+		// the tracing middleware wraps the retry middleware, to get a single span per call.
+		var rm middleware.Middleware
+		if o.RetryCount > 0 {
+			rm = &retry.RetryMiddleware{RetryWaitMin: &o.RetryWaitMin, RetryWaitMax: &o.RetryWaitMax, RetryMax: &o.RetryCount}
+		}
+		opts = append(opts, tracing.WithTracing(o.TracerProvider, rm))
+	case o.RetryCount > 0:
 		opts = append(opts, options.WithRetry(&o.RetryWaitMin, &o.RetryWaitMax, &o.RetryCount))
-	} else {
+	default:
 		opts = append(opts, options.WithoutRetry())
 	}
-	return opts
+	return append(opts, o.Middlewares...)
 }
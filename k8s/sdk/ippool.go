@@ -0,0 +1,148 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/outscale/goutils/k8s/tags"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+// IPPoolSpec is the desired state of an IP pool, with the semantics of the spec of the oks.dev IPPool resource.
+type IPPoolSpec struct {
+	// NumAddresses is the number of addresses of the pool.
+	NumAddresses int
+	// Persistent pools keep their addresses, and are never shrunk.
+	Persistent bool
+}
+
+// IPPoolProgress is the state of an IP pool, with the semantics of the status progress of the oks.dev IPPool resource.
+type IPPoolProgress struct {
+	AllocatedAddresses int
+	LinkedAddresses    int
+	UnlinkedAddresses  int
+}
+
+// Utilization returns the ratio of linked addresses, between 0 and 1.
+func (p IPPoolProgress) Utilization() float64 {
+	if p.AllocatedAddresses == 0 {
+		return 0
+	}
+	return float64(p.LinkedAddresses) / float64(p.AllocatedAddresses)
+}
+
+// IPPoolOptions defines the options of an IP pool manager.
+type IPPoolOptions struct {
+	// Tags are added to created addresses, in addition to the pool tag.
+	Tags []osc.ResourceTag
+	// ReplenishThreshold is the min number of free addresses maintained by Ensure, the pool growing beyond
+	// NumAddresses if needed. Replenishment is disabled if zero.
+	ReplenishThreshold int
+	// Claim defines how claims are checked. Claimed addresses are never deleted.
+	Claim ClaimOptions
+}
+
+// IPPoolManager manages the addresses of a pool of public IPs tagged with OscK8sIPPool.
+type IPPoolManager struct {
+	client osc.ClientInterface
+	name   string
+	spec   IPPoolSpec
+	opts   IPPoolOptions
+}
+
+// NewIPPoolManager creates a manager for an IP pool.
+func NewIPPoolManager(c osc.ClientInterface, name string, spec IPPoolSpec, opts IPPoolOptions) *IPPoolManager {
+	opts.Claim.setDefaults()
+	return &IPPoolManager{client: c, name: name, spec: spec, opts: opts}
+}
+
+// Progress returns the state of the pool.
+func (m *IPPoolManager) Progress(ctx context.Context) (IPPoolProgress, error) {
+	status, err := GetIPPoolStatus(ctx, m.name, m.client, m.opts.Claim)
+	if err != nil {
+		return IPPoolProgress{}, err
+	}
+	return IPPoolProgress{
+		AllocatedAddresses: status.Total,
+		LinkedAddresses:    status.Linked,
+		UnlinkedAddresses:  status.Free + status.Claimed,
+	}, nil
+}
+
+// Ensure creates or deletes addresses, for the pool to have NumAddresses addresses.
+// If ReplenishThreshold is set, addresses are added while fewer free addresses are available.
+// Only free addresses are deleted, and persistent pools are never shrunk.
+func (m *IPPoolManager) Ensure(ctx context.Context) (IPPoolProgress, error) {
+	log := klog.FromContext(ctx).WithValues("pool", m.name)
+	pips, err := readPool(ctx, m.name, m.client)
+	if err != nil {
+		return IPPoolProgress{}, fmt.Errorf("ensure pool: %w", err)
+	}
+	var free []osc.PublicIp
+	for _, pip := range pips {
+		if pip.LinkPublicIpId == nil && m.opts.Claim.claimHolder(&pip) == "" {
+			free = append(free, pip)
+		}
+	}
+	missing := max(m.spec.NumAddresses-len(pips), m.opts.ReplenishThreshold-len(free))
+	switch {
+	case missing > 0:
+		log.V(3).Info("Growing pool", "count", missing)
+		for range missing {
+			if _, err := m.create(ctx); err != nil {
+				return IPPoolProgress{}, fmt.Errorf("ensure pool: %w", err)
+			}
+		}
+	case len(pips) > m.spec.NumAddresses && !m.spec.Persistent:
+		// free addresses above the replenish threshold may be deleted.
+		excess := min(len(pips)-m.spec.NumAddresses, len(free)-m.opts.ReplenishThreshold)
+		if excess > 0 {
+			log.V(3).Info("Shrinking pool", "count", excess)
+		}
+		for _, pip := range free[:max(excess, 0)] {
+			_, err := m.client.DeletePublicIp(ctx, osc.DeletePublicIpRequest{PublicIpId: &pip.PublicIpId})
+			if err != nil && !sdkerrors.Is(err, sdkerrors.ErrNotFound) {
+				return IPPoolProgress{}, fmt.Errorf("ensure pool: delete public ip: %w", sdkerrors.Wrap(err))
+			}
+		}
+	}
+	return m.Progress(ctx)
+}
+
+// create creates a new address in the pool.
+func (m *IPPoolManager) create(ctx context.Context) (*osc.PublicIp, error) {
+	return createIP(ctx, m.client, append([]osc.ResourceTag{{Key: tags.PublicIPPool, Value: m.name}}, m.opts.Tags...))
+}
+
+// createIP creates a public IP and tags it. The IP is deleted if tagging fails.
+func createIP(ctx context.Context, c osc.ClientInterface, t []osc.ResourceTag) (*osc.PublicIp, error) {
+	resp, err := c.CreatePublicIp(ctx, osc.CreatePublicIpRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("create public ip: %w", sdkerrors.Wrap(err))
+	}
+	pip := resp.PublicIp
+	if pip == nil {
+		return nil, errors.New("create public ip: empty response")
+	}
+	klog.FromContext(ctx).V(4).Info("PublicIp created", "publicIpId", pip.PublicIpId, "publicIp", pip.PublicIp)
+	if len(t) == 0 {
+		return pip, nil
+	}
+	_, err = c.CreateTags(ctx, osc.CreateTagsRequest{ResourceIds: []string{pip.PublicIpId}, Tags: t})
+	if err != nil {
+		if _, derr := c.DeletePublicIp(ctx, osc.DeletePublicIpRequest{PublicIpId: &pip.PublicIpId}); derr != nil {
+			return nil, fmt.Errorf("tag public ip: %w (unable to delete %s: %w)", sdkerrors.Wrap(err), pip.PublicIpId, sdkerrors.Wrap(derr))
+		}
+		return nil, fmt.Errorf("tag public ip: %w", sdkerrors.Wrap(err))
+	}
+	pip.Tags = append(pip.Tags, t...)
+	return pip, nil
+}
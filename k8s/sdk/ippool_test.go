@@ -0,0 +1,101 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package sdk_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/sdk"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIPPoolManager(t *testing.T) {
+	poolReq := osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{Tags: &[]string{tags.PublicIPPool + "=foo"}}}
+	poolTags := []osc.ResourceTag{{Key: tags.PublicIPPool, Value: "foo"}, {Key: "team", Value: "bar"}}
+	opts := sdk.IPPoolOptions{Tags: poolTags[1:]}
+	t.Run("A pool is grown", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		pool := []osc.PublicIp{{PublicIpId: "linked", LinkPublicIpId: ptr.To("bar")}}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &pool}, nil)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "new1"}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Eq(osc.CreateTagsRequest{ResourceIds: []string{"new1"}, Tags: poolTags})).Return(&osc.CreateTagsResponse{}, nil)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "new2"}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Eq(osc.CreateTagsRequest{ResourceIds: []string{"new2"}, Tags: poolTags})).Return(&osc.CreateTagsResponse{}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			pool[0], {PublicIpId: "new1"}, {PublicIpId: "new2"},
+		}}, nil)
+
+		m := sdk.NewIPPoolManager(mockSDK, "foo", sdk.IPPoolSpec{NumAddresses: 3}, opts)
+		progress, err := m.Ensure(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.IPPoolProgress{AllocatedAddresses: 3, LinkedAddresses: 1, UnlinkedAddresses: 2}, progress)
+		assert.InDelta(t, 1.0/3, progress.Utilization(), 0.001)
+	})
+	t.Run("A pool is shrunk by deleting free addresses", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		pool := []osc.PublicIp{
+			{PublicIpId: "linked", LinkPublicIpId: ptr.To("bar")},
+			{PublicIpId: "claimed", Tags: claimTags("svc", time.Now())},
+			{PublicIpId: "free"},
+		}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &pool}, nil)
+		mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("free")})).Return(&osc.DeletePublicIpResponse{}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: ptr.To(pool[:2])}, nil)
+
+		m := sdk.NewIPPoolManager(mockSDK, "foo", sdk.IPPoolSpec{NumAddresses: 1}, opts)
+		progress, err := m.Ensure(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, sdk.IPPoolProgress{AllocatedAddresses: 2, LinkedAddresses: 1, UnlinkedAddresses: 1}, progress)
+	})
+	t.Run("A persistent pool is not shrunk", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		pool := []osc.PublicIp{{PublicIpId: "free1"}, {PublicIpId: "free2"}}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &pool}, nil).Times(2)
+
+		m := sdk.NewIPPoolManager(mockSDK, "foo", sdk.IPPoolSpec{NumAddresses: 1, Persistent: true}, opts)
+		progress, err := m.Ensure(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2, progress.AllocatedAddresses)
+	})
+	t.Run("A pool is replenished", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		pool := []osc.PublicIp{{PublicIpId: "linked", LinkPublicIpId: ptr.To("bar")}, {PublicIpId: "free"}}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &pool}, nil)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "new"}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Return(&osc.CreateTagsResponse{}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: ptr.To(append(pool, osc.PublicIp{PublicIpId: "new"}))}, nil)
+
+		m := sdk.NewIPPoolManager(mockSDK, "foo", sdk.IPPoolSpec{NumAddresses: 2}, sdk.IPPoolOptions{ReplenishThreshold: 2})
+		progress, err := m.Ensure(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3, progress.AllocatedAddresses)
+	})
+	t.Run("A created IP is deleted if tagging fails", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		errTag := errors.New("tag error")
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{}, nil)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "new"}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Return(nil, errTag)
+		mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("new")})).Return(&osc.DeletePublicIpResponse{}, nil)
+
+		m := sdk.NewIPPoolManager(mockSDK, "foo", sdk.IPPoolSpec{NumAddresses: 1}, opts)
+		_, err := m.Ensure(t.Context())
+		require.ErrorIs(t, err, errTag)
+	})
+}
@@ -6,46 +6,297 @@ package sdk
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math/rand/v2"
+	"time"
 
 	"github.com/outscale/goutils/k8s/tags"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/ptr"
+	sdktags "github.com/outscale/goutils/sdk/tags"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
 	"k8s.io/klog/v2"
 )
 
 var (
 	// ErrEmptyPool is returned when one requests an API from an empty pool.
-	ErrEmptyPool = errors.New("no available IP in pool")
+	ErrEmptyPool = sdkerrors.New("no available IP in pool", sdkerrors.ErrQuotaExceeded)
+	// ErrNotClaimed is returned when releasing an IP claimed by another holder.
+	ErrNotClaimed = sdkerrors.New("IP is not claimed by holder", sdkerrors.ErrConflict)
 )
 
+const (
+	// DefaultClaimTTL is the default time after which an unlinked claimed IP may be claimed again.
+	DefaultClaimTTL = 5 * time.Minute
+	// DefaultClaimSettleDelay is the default wait between a claim and its confirmation.
+	DefaultClaimSettleDelay = time.Second
+)
+
+// ClaimOptions defines the options of IP pool claims.
+type ClaimOptions struct {
+	// TTL is the time after which an unlinked claimed IP may be claimed again. Defaults to DefaultClaimTTL.
+	TTL time.Duration
+	// SettleDelay is the wait between a claim and its confirmation, for concurrent claims to be visible.
+	// Defaults to DefaultClaimSettleDelay.
+	SettleDelay time.Duration
+	// Clock returns the current time. Defaults to time.Now.
+	Clock func() time.Time
+}
+
+func (o *ClaimOptions) setDefaults() {
+	if o.TTL == 0 {
+		o.TTL = DefaultClaimTTL
+	}
+	if o.SettleDelay == 0 {
+		o.SettleDelay = DefaultClaimSettleDelay
+	}
+	if o.Clock == nil {
+		o.Clock = time.Now
+	}
+}
+
+// claimHolder returns the holder of a claim, or an empty string if the IP is not claimed or if the claim has expired.
+func (o *ClaimOptions) claimHolder(pip *osc.PublicIp) string {
+	holder, found := tags.GetValue(pip.Tags, tags.PublicIPClaim)
+	if !found || pip.LinkPublicIpId != nil {
+		return holder
+	}
+	at, err := time.Parse(time.RFC3339, tags.Must(tags.GetValue(pip.Tags, tags.PublicIPClaimTime)))
+	if err != nil || o.Clock().Sub(at) >= o.TTL {
+		return ""
+	}
+	return holder
+}
+
+func readPool(ctx context.Context, pool string, c osc.ClientInterface) ([]osc.PublicIp, error) {
+	req := osc.ReadPublicIpsRequest{
+		Filters: sdktags.Filters[osc.FiltersPublicIp](sdktags.NewFilter().Equal(tags.PublicIPPool, pool)),
+	}
+	var res []osc.PublicIp
+	for {
+		resp, err := c.ReadPublicIps(ctx, req)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err)
+		}
+		res = append(res, ptr.From(resp.PublicIps)...)
+		if ptr.From(resp.NextPageToken) == "" {
+			return res, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// AllocateIPFromPool returns a random unlinked IP from a pool. IPs having an active claim are skipped.
+// Concurrent calls may return the same IP, ClaimIPFromPool needs to be used to avoid collisions.
 func AllocateIPFromPool(ctx context.Context, pool string, c osc.ClientInterface) (*osc.PublicIp, error) {
 	log := klog.FromContext(ctx)
 	log.V(4).Info("Fetching publicIps from pool", "pool", pool)
-	req := osc.ReadPublicIpsRequest{
-		Filters: &osc.FiltersPublicIp{
-			TagKeys:   &[]string{tags.PublicIPPool},
-			TagValues: &[]string{pool},
-		},
-	}
-	resp, err := c.ReadPublicIps(ctx, req)
+	pips, err := readPool(ctx, pool, c)
 	if err != nil {
 		return nil, fmt.Errorf("allocate from pool: %w", err)
 	}
-	if len(*resp.PublicIps) == 0 {
+	if len(pips) == 0 {
 		return nil, ErrEmptyPool
 	}
-	pips := *resp.PublicIps
+	opts := ClaimOptions{}
+	opts.setDefaults()
 	// randomly fetch from the list, to limit the chance of allocating
 	// the same IP to two concurrent requests
 	off := rand.IntN(len(pips)) //nolint:gosec
 	for i := range pips {
 		pip := pips[(off+i)%len(pips)]
-		if pip.LinkPublicIpId == nil {
+		if pip.LinkPublicIpId == nil && opts.claimHolder(&pip) == "" {
 			log.V(3).Info("Found publicIp in pool", "publicIpId", pip.PublicIpId, "publicIp", pip.PublicIp)
 			return &pip, nil
 		}
 	}
 	return nil, ErrEmptyPool
 }
+
+// ClaimIPFromPool claims an unlinked IP from a pool.
+//
+// The IP is read again, tagged with the holder and the claim time if still free, and read back after the settle
+// delay to confirm that no concurrent claim has won. If the claim is lost, another IP is tried.
+// Claims expire after the TTL if the IP has not been linked.
+// An IP already claimed by the holder is returned, making ClaimIPFromPool idempotent.
+//
+// LinkPublicIp needs to be called with AllowRelink set to false, to detect any remaining conflict.
+func ClaimIPFromPool(ctx context.Context, pool, holder string, c osc.ClientInterface, opts ClaimOptions) (*osc.PublicIp, error) {
+	opts.setDefaults()
+	log := klog.FromContext(ctx).WithValues("pool", pool, "holder", holder)
+	log.V(4).Info("Fetching publicIps from pool")
+	pips, err := readPool(ctx, pool, c)
+	if err != nil {
+		return nil, fmt.Errorf("claim from pool: %w", err)
+	}
+	var candidates []osc.PublicIp
+	for _, pip := range pips {
+		switch opts.claimHolder(&pip) {
+		case holder:
+			log.V(3).Info("PublicIp already claimed", "publicIpId", pip.PublicIpId, "publicIp", pip.PublicIp)
+			return &pip, nil
+		case "":
+			if pip.LinkPublicIpId == nil {
+				candidates = append(candidates, pip)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, ErrEmptyPool
+	}
+	// randomly fetch from the list, to limit the chance of concurrent claims on the same IP
+	off := rand.IntN(len(candidates)) //nolint:gosec
+	for i := range candidates {
+		pip := candidates[(off+i)%len(candidates)]
+		claimed, err := claimIP(ctx, pip.PublicIpId, holder, c, opts)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("claim from pool: %w", err)
+		case claimed != nil:
+			log.V(3).Info("PublicIp claimed", "publicIpId", claimed.PublicIpId, "publicIp", claimed.PublicIp)
+			return claimed, nil
+		}
+		log.V(4).Info("PublicIp claimed by another holder", "publicIpId", pip.PublicIpId)
+	}
+	return nil, ErrEmptyPool
+}
+
+// claimIP claims an IP, and returns nil if the claim was lost.
+func claimIP(ctx context.Context, publicIpID, holder string, c osc.ClientInterface, opts ClaimOptions) (*osc.PublicIp, error) {
+	// the IP is read again before being tagged, as a concurrent claim may have been confirmed since the pool was read.
+	pip, err := readIP(ctx, publicIpID, c)
+	if err != nil {
+		return nil, err
+	}
+	switch opts.claimHolder(pip) {
+	case holder:
+		return pip, nil
+	case "":
+		if pip.LinkPublicIpId != nil {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	_, err = c.CreateTags(ctx, osc.CreateTagsRequest{
+		ResourceIds: []string{publicIpID},
+		Tags: []osc.ResourceTag{
+			{Key: tags.PublicIPClaim, Value: holder},
+			{Key: tags.PublicIPClaimTime, Value: opts.Clock().UTC().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create claim tags: %w", sdkerrors.Wrap(err))
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(opts.SettleDelay):
+	}
+	pip, err = readIP(ctx, publicIpID, c)
+	if err != nil {
+		return nil, err
+	}
+	if pip.LinkPublicIpId != nil || tags.Must(tags.GetValue(pip.Tags, tags.PublicIPClaim)) != holder {
+		return nil, nil
+	}
+	return pip, nil
+}
+
+func readIP(ctx context.Context, publicIpID string, c osc.ClientInterface) (*osc.PublicIp, error) {
+	resp, err := c.ReadPublicIps(ctx, osc.ReadPublicIpsRequest{
+		Filters: &osc.FiltersPublicIp{PublicIpIds: &[]string{publicIpID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read public ip: %w", sdkerrors.Wrap(err))
+	}
+	if len(ptr.From(resp.PublicIps)) != 1 {
+		return nil, fmt.Errorf("read public ip: %s: %w", publicIpID, sdkerrors.ErrNotFound)
+	}
+	return &(*resp.PublicIps)[0], nil
+}
+
+// ReleaseIPToPool returns an IP claimed by a holder to its pool.
+// The IP is unlinked if needed, and its claim tags are deleted.
+// Releasing an unclaimed IP does nothing, and ErrNotClaimed is returned if the IP is claimed by another holder.
+func ReleaseIPToPool(ctx context.Context, publicIpID, holder string, c osc.ClientInterface) error {
+	log := klog.FromContext(ctx).WithValues("publicIpId", publicIpID, "holder", holder)
+	pip, err := readIP(ctx, publicIpID, c)
+	if err != nil {
+		return fmt.Errorf("release to pool: %w", err)
+	}
+	current, found := tags.GetValue(pip.Tags, tags.PublicIPClaim)
+	switch {
+	case !found:
+		return nil
+	case current != holder:
+		return fmt.Errorf("release to pool: %w: claimed by %s", ErrNotClaimed, current)
+	}
+	if pip.LinkPublicIpId != nil {
+		log.V(4).Info("Unlinking publicIp")
+		_, err := c.UnlinkPublicIp(ctx, osc.UnlinkPublicIpRequest{LinkPublicIpId: pip.LinkPublicIpId})
+		if err != nil && !sdkerrors.Is(err, sdkerrors.ErrNotFound) {
+			return fmt.Errorf("release to pool: unlink: %w", sdkerrors.Wrap(err))
+		}
+	}
+	if err := deleteClaim(ctx, pip, c); err != nil {
+		return fmt.Errorf("release to pool: %w", err)
+	}
+	log.V(3).Info("PublicIp released")
+	return nil
+}
+
+// deleteClaim deletes the claim tags of an IP. Tags are deleted with the values read, as OAPI only deletes tags
+// matching both key and value, and a claim taken by another holder since the read is not deleted.
+func deleteClaim(ctx context.Context, pip *osc.PublicIp, c osc.ClientInterface) error {
+	var claim []osc.ResourceTag
+	for _, tag := range pip.Tags {
+		if tag.Key == tags.PublicIPClaim || tag.Key == tags.PublicIPClaimTime {
+			claim = append(claim, tag)
+		}
+	}
+	if len(claim) == 0 {
+		return nil
+	}
+	_, err := c.DeleteTags(ctx, osc.DeleteTagsRequest{
+		ResourceIds: []string{pip.PublicIpId},
+		Tags:        claim,
+	})
+	if err != nil {
+		return fmt.Errorf("delete claim tags: %w", sdkerrors.Wrap(err))
+	}
+	return nil
+}
+
+// IPPoolStatus is the status of an IP pool.
+type IPPoolStatus struct {
+	// Total is the number of IPs in the pool.
+	Total int
+	// Free is the number of IPs neither linked nor claimed.
+	Free int
+	// Claimed is the number of unlinked IPs having an active claim.
+	Claimed int
+	// Linked is the number of linked IPs.
+	Linked int
+}
+
+// GetIPPoolStatus counts the free, claimed and linked IPs of a pool.
+func GetIPPoolStatus(ctx context.Context, pool string, c osc.ClientInterface, opts ClaimOptions) (IPPoolStatus, error) {
+	opts.setDefaults()
+	pips, err := readPool(ctx, pool, c)
+	if err != nil {
+		return IPPoolStatus{}, fmt.Errorf("pool status: %w", err)
+	}
+	status := IPPoolStatus{Total: len(pips)}
+	for _, pip := range pips {
+		switch {
+		case pip.LinkPublicIpId != nil:
+			status.Linked++
+		case opts.claimHolder(&pip) != "":
+			status.Claimed++
+		default:
+			status.Free++
+		}
+	}
+	return status, nil
+}
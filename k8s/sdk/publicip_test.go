@@ -1,12 +1,20 @@
 package sdk_test
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/outscale/goutils/k8s/sdk"
 	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/chaos"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/fixtures"
 	"github.com/outscale/goutils/sdk/mocks_osc"
 	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,10 +25,9 @@ func TestAllocateIPFromPool(t *testing.T) {
 	t.Run("An IP is allocated", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(osc.ReadPublicIpsRequest{
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), mocks_osc.Eq(osc.ReadPublicIpsRequest{
 			Filters: &osc.FiltersPublicIp{
-				TagKeys:   &[]string{tags.PublicIPPool},
-				TagValues: &[]string{"foo"},
+				Tags: &[]string{tags.PublicIPPool + "=foo"},
 			},
 		})).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
 			{PublicIpId: "bar", LinkPublicIpId: ptr.To("bar")},
@@ -34,23 +41,22 @@ func TestAllocateIPFromPool(t *testing.T) {
 	t.Run("ErrEmptyPool is returned if no IP is found", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(osc.ReadPublicIpsRequest{
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), mocks_osc.Eq(osc.ReadPublicIpsRequest{
 			Filters: &osc.FiltersPublicIp{
-				TagKeys:   &[]string{tags.PublicIPPool},
-				TagValues: &[]string{"foo"},
+				Tags: &[]string{tags.PublicIPPool + "=foo"},
 			},
 		})).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{}}, nil)
 
 		_, err := sdk.AllocateIPFromPool(t.Context(), "foo", mockSDK)
 		require.ErrorIs(t, err, sdk.ErrEmptyPool)
+		require.ErrorIs(t, err, sdkerrors.ErrQuotaExceeded)
 	})
 	t.Run("ErrEmptyPool is returned if all IP are already allocated", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(osc.ReadPublicIpsRequest{
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), mocks_osc.Eq(osc.ReadPublicIpsRequest{
 			Filters: &osc.FiltersPublicIp{
-				TagKeys:   &[]string{tags.PublicIPPool},
-				TagValues: &[]string{"foo"},
+				Tags: &[]string{tags.PublicIPPool + "=foo"},
 			},
 		})).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
 			{PublicIpId: "bar", LinkPublicIpId: ptr.To("bar")},
@@ -60,4 +66,225 @@ func TestAllocateIPFromPool(t *testing.T) {
 		_, err := sdk.AllocateIPFromPool(t.Context(), "foo", mockSDK)
 		require.ErrorIs(t, err, sdk.ErrEmptyPool)
 	})
+	t.Run("A linked IP may be allocated while reads are not consistent", func(t *testing.T) {
+		fake := fake_osc.NewClient(fake_osc.Options{})
+		vm := fixtures.New().Vm().Build()
+		require.NoError(t, fake.Add(vm))
+		c := chaos.NewClient(fake, 1)
+		c.SetConsistency("CreatePublicIp", chaos.Consistency{Reads: 1, Stale: true})
+		res, err := c.CreatePublicIp(t.Context(), osc.CreatePublicIpRequest{})
+		require.NoError(t, err)
+		id := res.PublicIp.PublicIpId
+		_, err = c.CreateTags(t.Context(), osc.CreateTagsRequest{
+			ResourceIds: []string{id},
+			Tags:        []osc.ResourceTag{{Key: tags.PublicIPPool, Value: "foo"}},
+		})
+		require.NoError(t, err)
+		_, err = c.LinkPublicIp(t.Context(), osc.LinkPublicIpRequest{PublicIpId: &id, VmId: &vm.VmId})
+		require.NoError(t, err)
+
+		ip, err := sdk.AllocateIPFromPool(t.Context(), "foo", c)
+		require.NoError(t, err, "the stale IP is seen as unlinked")
+		assert.Equal(t, id, ip.PublicIpId)
+		_, err = c.LinkPublicIp(t.Context(), osc.LinkPublicIpRequest{PublicIpId: &id, VmId: &vm.VmId, AllowRelink: ptr.To(false)})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInUse), "the conflict is detected when linking")
+
+		_, err = sdk.AllocateIPFromPool(t.Context(), "foo", c)
+		require.ErrorIs(t, err, sdk.ErrEmptyPool, "the IP is seen as linked once reads are consistent")
+	})
+}
+
+func claimTags(holder string, at time.Time) []osc.ResourceTag {
+	return []osc.ResourceTag{
+		{Key: tags.PublicIPPool, Value: "foo"},
+		{Key: tags.PublicIPClaim, Value: holder},
+		{Key: tags.PublicIPClaimTime, Value: at.UTC().Format(time.RFC3339)},
+	}
+}
+
+func TestClaimIPFromPool(t *testing.T) {
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	opts := sdk.ClaimOptions{SettleDelay: time.Nanosecond, Clock: func() time.Time { return now }}
+	poolReq := osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{Tags: &[]string{tags.PublicIPPool + "=foo"}}}
+	readReq := func(id string) osc.ReadPublicIpsRequest {
+		return osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{PublicIpIds: &[]string{id}}}
+	}
+	claimReq := func(id string) osc.CreateTagsRequest {
+		return osc.CreateTagsRequest{ResourceIds: []string{id}, Tags: claimTags("svc", now)[1:]}
+	}
+	t.Run("An unlinked IP is claimed, skipping active claims", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "linked", LinkPublicIpId: ptr.To("bar")},
+			{PublicIpId: "claimed", Tags: claimTags("other", now.Add(-time.Minute))},
+			{PublicIpId: "stale", Tags: claimTags("other", now.Add(-time.Hour))},
+		}}, nil)
+		gomock.InOrder(
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(readReq("stale"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "stale", Tags: claimTags("other", now.Add(-time.Hour))},
+			}}, nil),
+			mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Eq(claimReq("stale"))).Return(&osc.CreateTagsResponse{}, nil),
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(readReq("stale"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "stale", Tags: claimTags("svc", now)},
+			}}, nil),
+		)
+
+		ip, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", mockSDK, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "stale", ip.PublicIpId)
+	})
+	t.Run("Another IP is tried when a claim is lost", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar"}, {PublicIpId: "baz"},
+		}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Return(&osc.CreateTagsResponse{}, nil).Times(2)
+		var claimed []string
+		reads := map[string]int{}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req osc.ReadPublicIpsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpsResponse, error) {
+				id := (*req.Filters.PublicIpIds)[0]
+				reads[id]++
+				if reads[id] == 1 {
+					return &osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{{PublicIpId: id}}}, nil
+				}
+				holder := "svc"
+				if len(claimed) == 0 {
+					holder = "other"
+				}
+				claimed = append(claimed, id)
+				return &osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{{PublicIpId: id, Tags: claimTags(holder, now)}}}, nil
+			}).Times(4)
+
+		ip, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", mockSDK, opts)
+		require.NoError(t, err)
+		require.Len(t, claimed, 2)
+		assert.Equal(t, claimed[1], ip.PublicIpId)
+	})
+	t.Run("An IP claimed by another holder since the pool was read is not tagged", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar"},
+		}}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(readReq("bar"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar", Tags: claimTags("other", now)},
+		}}, nil)
+
+		_, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", mockSDK, opts)
+		require.ErrorIs(t, err, sdk.ErrEmptyPool)
+	})
+	t.Run("Concurrent holders never claim the same IP", func(t *testing.T) {
+		fake := fake_osc.NewClient(fake_osc.Options{})
+		for range 2 {
+			res, err := fake.CreatePublicIp(t.Context(), osc.CreatePublicIpRequest{})
+			require.NoError(t, err)
+			_, err = fake.CreateTags(t.Context(), osc.CreateTagsRequest{
+				ResourceIds: []string{res.PublicIp.PublicIpId},
+				Tags:        []osc.ResourceTag{{Key: tags.PublicIPPool, Value: "foo"}},
+			})
+			require.NoError(t, err)
+		}
+		var (
+			wg  sync.WaitGroup
+			ips [2]*osc.PublicIp
+		)
+		for i, holder := range []string{"svc-a", "svc-b"} {
+			wg.Go(func() {
+				ip, err := sdk.ClaimIPFromPool(t.Context(), "foo", holder, fake, sdk.ClaimOptions{SettleDelay: 10 * time.Millisecond})
+				assert.NoError(t, err)
+				ips[i] = ip
+			})
+		}
+		wg.Wait()
+		require.NotNil(t, ips[0])
+		require.NotNil(t, ips[1])
+		assert.NotEqual(t, ips[0].PublicIpId, ips[1].PublicIpId)
+	})
+	t.Run("An IP already claimed by the holder is returned", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar"},
+			{PublicIpId: "baz", LinkPublicIpId: ptr.To("baz"), Tags: claimTags("svc", now.Add(-time.Hour))},
+		}}, nil)
+
+		ip, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", mockSDK, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "baz", ip.PublicIpId)
+	})
+	t.Run("ErrEmptyPool is returned if all IP are claimed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(poolReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar", Tags: claimTags("other", now)},
+		}}, nil)
+
+		_, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", mockSDK, opts)
+		require.ErrorIs(t, err, sdk.ErrEmptyPool)
+	})
+}
+
+func TestReleaseIPToPool(t *testing.T) {
+	now := time.Now()
+	readReq := osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{PublicIpIds: &[]string{"bar"}}}
+	t.Run("A claimed IP is unlinked and released", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(readReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar", LinkPublicIpId: ptr.To("eipassoc-bar"), Tags: claimTags("svc", now)},
+		}}, nil)
+		mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Eq(osc.UnlinkPublicIpRequest{LinkPublicIpId: ptr.To("eipassoc-bar")})).
+			Return(&osc.UnlinkPublicIpResponse{}, nil)
+		mockSDK.EXPECT().DeleteTags(gomock.Any(), gomock.Eq(osc.DeleteTagsRequest{
+			ResourceIds: []string{"bar"},
+			Tags:        claimTags("svc", now)[1:],
+		})).Return(&osc.DeleteTagsResponse{}, nil)
+
+		require.NoError(t, sdk.ReleaseIPToPool(t.Context(), "bar", "svc", mockSDK))
+	})
+	t.Run("A released IP is free", func(t *testing.T) {
+		fake := fake_osc.NewClient(fake_osc.Options{})
+		res, err := fake.CreatePublicIp(t.Context(), osc.CreatePublicIpRequest{})
+		require.NoError(t, err)
+		_, err = fake.CreateTags(t.Context(), osc.CreateTagsRequest{
+			ResourceIds: []string{res.PublicIp.PublicIpId},
+			Tags:        []osc.ResourceTag{{Key: tags.PublicIPPool, Value: "foo"}},
+		})
+		require.NoError(t, err)
+		opts := sdk.ClaimOptions{SettleDelay: time.Nanosecond}
+		ip, err := sdk.ClaimIPFromPool(t.Context(), "foo", "svc", fake, opts)
+		require.NoError(t, err)
+		require.NoError(t, sdk.ReleaseIPToPool(t.Context(), ip.PublicIpId, "svc", fake))
+		status, err := sdk.GetIPPoolStatus(t.Context(), "foo", fake, opts)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.IPPoolStatus{Total: 1, Free: 1}, status)
+	})
+	t.Run("ErrNotClaimed is returned if the IP is claimed by another holder", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(readReq)).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "bar", LinkPublicIpId: ptr.To("eipassoc-bar"), Tags: claimTags("other", now)},
+		}}, nil)
+
+		require.ErrorIs(t, sdk.ReleaseIPToPool(t.Context(), "bar", "svc", mockSDK), sdk.ErrNotClaimed)
+	})
+}
+
+func TestGetIPPoolStatus(t *testing.T) {
+	now := time.Now()
+	mockCtrl := gomock.NewController(t)
+	mockSDK := mocks_osc.NewMockClient(mockCtrl)
+	mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+		{PublicIpId: "free"},
+		{PublicIpId: "stale", Tags: claimTags("svc", now.Add(-time.Hour))},
+		{PublicIpId: "claimed", Tags: claimTags("svc", now)},
+		{PublicIpId: "linked", LinkPublicIpId: ptr.To("bar"), Tags: claimTags("svc", now.Add(-time.Hour))},
+	}}, nil)
+
+	status, err := sdk.GetIPPoolStatus(t.Context(), "foo", mockSDK, sdk.ClaimOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.IPPoolStatus{Total: 4, Free: 2, Claimed: 1, Linked: 1}, status)
 }
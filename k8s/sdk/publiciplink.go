@@ -0,0 +1,184 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/outscale/goutils/k8s/batch"
+	"github.com/outscale/goutils/k8s/tags"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+// LinkIPOptions defines how a public IP is allocated and linked.
+type LinkIPOptions struct {
+	// Pool is the pool the IP is claimed from. A new IP is created if empty.
+	Pool string
+	// Holder is the claim holder, required if Pool is set.
+	Holder string
+	// Claim defines the claim options.
+	Claim ClaimOptions
+	// Tags are added to created IPs.
+	Tags []osc.ResourceTag
+
+	// VmID is the VM the IP is linked to. Either VmID or NicID is required.
+	VmID string
+	// NicID is the NIC the IP is linked to.
+	NicID string
+	// PrivateIP is the private IP of the NIC the IP is linked to. The primary private IP is used if empty.
+	PrivateIP string
+	// AllowRelink allows linking an IP that is already linked. It should not be set for pool IPs,
+	// a failed link being the final guard against concurrent claims.
+	AllowRelink bool
+}
+
+// AllocateAndLinkIP claims an IP from a pool, or creates an IP, links it and waits until the link is visible.
+// The batcher needs to be running.
+//
+// On failure, the steps done by the call are undone: the link created by the call is removed, and the IP is either
+// released to its pool by deleting its claim tags, or deleted.
+func AllocateAndLinkIP(ctx context.Context, c osc.ClientInterface, b *batch.BatcherByID[osc.PublicIp], opts LinkIPOptions) (pip *osc.PublicIp, err error) {
+	log := klog.FromContext(ctx)
+	switch {
+	case opts.VmID == "" && opts.NicID == "":
+		return nil, errors.New("allocate and link: a VM or a NIC is required")
+	case opts.Pool != "" && opts.Holder == "":
+		return nil, errors.New("allocate and link: a holder is required to claim from a pool")
+	}
+
+	var undo []func(ctx context.Context) error
+	defer func() {
+		if err == nil {
+			return
+		}
+		// undo also needs to run if ctx has been cancelled.
+		ctx := context.WithoutCancel(ctx)
+		for i := len(undo) - 1; i >= 0; i-- {
+			if uerr := undo[i](ctx); uerr != nil {
+				log.Error(uerr, "Unable to rollback publicIp allocation")
+				err = errors.Join(err, fmt.Errorf("rollback: %w", uerr))
+			}
+		}
+	}()
+
+	if opts.Pool != "" {
+		pip, err = ClaimIPFromPool(ctx, opts.Pool, opts.Holder, c, opts.Claim)
+	} else {
+		pip, err = createIP(ctx, c, opts.Tags)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("allocate and link: %w", err)
+	}
+	id, claimed := pip.PublicIpId, *pip
+	undo = append(undo, func(ctx context.Context) error {
+		if opts.Pool != "" {
+			// the IP may only be unlinked by the undo of the link created by this call.
+			return deleteClaim(ctx, &claimed, c)
+		}
+		_, err := c.DeletePublicIp(ctx, osc.DeletePublicIpRequest{PublicIpId: &id})
+		return sdkerrors.Wrap(err)
+	})
+	if pip.LinkPublicIpId != nil && linkedTo(pip, opts) {
+		// already linked by a previous call.
+		return pip, nil
+	}
+
+	log.V(4).Info("Linking publicIp", "publicIpId", id, "vmId", opts.VmID, "nicId", opts.NicID)
+	req := osc.LinkPublicIpRequest{PublicIpId: &id, AllowRelink: &opts.AllowRelink}
+	if opts.VmID != "" {
+		req.VmId = &opts.VmID
+	}
+	if opts.NicID != "" {
+		req.NicId = &opts.NicID
+	}
+	if opts.PrivateIP != "" {
+		req.PrivateIp = &opts.PrivateIP
+	}
+	resp, err := c.LinkPublicIp(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("allocate and link: link: %w", sdkerrors.Wrap(err))
+	}
+	if resp.LinkPublicIpId != nil {
+		linkID := *resp.LinkPublicIpId
+		undo = append(undo, func(ctx context.Context) error {
+			_, err := c.UnlinkPublicIp(ctx, osc.UnlinkPublicIpRequest{LinkPublicIpId: &linkID})
+			if sdkerrors.Is(err, sdkerrors.ErrNotFound) {
+				return nil
+			}
+			return sdkerrors.Wrap(err)
+		})
+	}
+
+	pip, err = b.WaitUntil(ctx, id, func(pip *osc.PublicIp) (bool, error) {
+		return pip.LinkPublicIpId != nil && linkedTo(pip, opts), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("allocate and link: wait: %w", err)
+	}
+	log.V(3).Info("PublicIp linked", "publicIpId", id, "publicIp", pip.PublicIp, "vmId", pip.VmId, "nicId", pip.NicId)
+	return pip, nil
+}
+
+func linkedTo(pip *osc.PublicIp, opts LinkIPOptions) bool {
+	switch {
+	case opts.NicID != "" && ptr.From(pip.NicId) != opts.NicID:
+		return false
+	case opts.VmID != "" && ptr.From(pip.VmId) != opts.VmID:
+		return false
+	case opts.PrivateIP != "" && ptr.From(pip.PrivateIp) != opts.PrivateIP:
+		return false
+	default:
+		return true
+	}
+}
+
+// UnlinkAndReleaseIP unlinks an IP and waits until it is unlinked. The batcher needs to be running.
+// IPs from a pool are then released to their pool, and other IPs are deleted.
+// ErrNotClaimed is returned, without unlinking the IP, if a pool IP is claimed by another holder.
+func UnlinkAndReleaseIP(ctx context.Context, c osc.ClientInterface, b *batch.BatcherByID[osc.PublicIp], publicIpID, holder string) error {
+	log := klog.FromContext(ctx).WithValues("publicIpId", publicIpID)
+	pip, err := b.Read(ctx, publicIpID)
+	switch {
+	case errors.Is(err, batch.ErrNotFound):
+		return nil
+	case err != nil:
+		return fmt.Errorf("unlink and release: %w", err)
+	}
+	pool := tags.Has(pip.Tags, tags.PublicIPPool)
+	if current, found := tags.GetValue(pip.Tags, tags.PublicIPClaim); pool && found && current != holder {
+		return fmt.Errorf("unlink and release: %w: claimed by %s", ErrNotClaimed, current)
+	}
+	if pip.LinkPublicIpId != nil {
+		log.V(4).Info("Unlinking publicIp")
+		_, err := c.UnlinkPublicIp(ctx, osc.UnlinkPublicIpRequest{LinkPublicIpId: pip.LinkPublicIpId})
+		if err != nil && !sdkerrors.Is(err, sdkerrors.ErrNotFound) {
+			return fmt.Errorf("unlink and release: unlink: %w", sdkerrors.Wrap(err))
+		}
+		_, err = b.WaitUntil(ctx, publicIpID, func(pip *osc.PublicIp) (bool, error) {
+			return pip.LinkPublicIpId == nil, nil
+		})
+		if err != nil {
+			return fmt.Errorf("unlink and release: wait: %w", err)
+		}
+	}
+	if pool {
+		if err := ReleaseIPToPool(ctx, publicIpID, holder, c); err != nil {
+			return fmt.Errorf("unlink and release: %w", err)
+		}
+		return nil
+	}
+	_, err = c.DeletePublicIp(ctx, osc.DeletePublicIpRequest{PublicIpId: &publicIpID})
+	if err != nil && !sdkerrors.Is(err, sdkerrors.ErrNotFound) {
+		return fmt.Errorf("unlink and release: delete: %w", sdkerrors.Wrap(err))
+	}
+	log.V(3).Info("PublicIp unlinked and deleted")
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/batch"
+	"github.com/outscale/goutils/k8s/sdk"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func batcherReq(id string) osc.ReadPublicIpsRequest {
+	return osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{PublicIpIds: &[]string{id}}, ResultsPerPage: ptr.To(1)}
+}
+
+func runBatcher(t *testing.T, mockSDK *mocks_osc.MockClient) *batch.BatcherByID[osc.PublicIp] {
+	b := batch.NewPublicIpBatcherByID(10*time.Millisecond, mockSDK)
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+	go b.Run(ctx)
+	return b
+}
+
+func TestAllocateAndLinkIP(t *testing.T) {
+	conflict := &osc.ErrorResponse{Errors: []osc.Errors{{Code: "9029", Type: "ResourceConflict"}}}
+	t.Run("An IP is created and linked", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "foo"}}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Eq(osc.CreateTagsRequest{
+			ResourceIds: []string{"foo"}, Tags: []osc.ResourceTag{{Key: "team", Value: "bar"}},
+		})).Return(&osc.CreateTagsResponse{}, nil)
+		mockSDK.EXPECT().LinkPublicIp(gomock.Any(), gomock.Eq(osc.LinkPublicIpRequest{
+			PublicIpId: ptr.To("foo"), NicId: ptr.To("eni-foo"), PrivateIp: ptr.To("10.0.0.2"), AllowRelink: ptr.To(false),
+		})).Return(&osc.LinkPublicIpResponse{LinkPublicIpId: ptr.To("eipassoc-foo")}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "foo", LinkPublicIpId: ptr.To("eipassoc-foo"), NicId: ptr.To("eni-foo"), PrivateIp: ptr.To("10.0.0.2")},
+		}}, nil)
+
+		pip, err := sdk.AllocateAndLinkIP(t.Context(), mockSDK, runBatcher(t, mockSDK), sdk.LinkIPOptions{
+			Tags:      []osc.ResourceTag{{Key: "team", Value: "bar"}},
+			NicID:     "eni-foo",
+			PrivateIP: "10.0.0.2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "eipassoc-foo", ptr.From(pip.LinkPublicIpId))
+	})
+	t.Run("A claimed IP is released if link fails", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		claimed := []osc.PublicIp{{PublicIpId: "foo", Tags: claimTags("svc", time.Now())}}
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{{PublicIpId: "foo"}}}, nil).Times(2)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Any()).Return(&osc.CreateTagsResponse{}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).Return(&osc.ReadPublicIpsResponse{PublicIps: &claimed}, nil)
+		mockSDK.EXPECT().LinkPublicIp(gomock.Any(), gomock.Any()).Return(nil, conflict)
+		mockSDK.EXPECT().DeleteTags(gomock.Any(), gomock.Eq(osc.DeleteTagsRequest{
+			ResourceIds: []string{"foo"},
+			Tags:        claimed[0].Tags[1:],
+		})).Return(&osc.DeleteTagsResponse{}, nil)
+
+		_, err := sdk.AllocateAndLinkIP(t.Context(), mockSDK, runBatcher(t, mockSDK), sdk.LinkIPOptions{
+			Pool:   "pool",
+			Holder: "svc",
+			Claim:  sdk.ClaimOptions{SettleDelay: time.Nanosecond},
+			VmID:   "i-foo",
+		})
+		require.ErrorIs(t, err, conflict)
+	})
+	t.Run("A created IP is unlinked and deleted if wait fails", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().CreatePublicIp(gomock.Any(), gomock.Any()).Return(&osc.CreatePublicIpResponse{PublicIp: &osc.PublicIp{PublicIpId: "foo"}}, nil)
+		mockSDK.EXPECT().LinkPublicIp(gomock.Any(), gomock.Any()).Return(&osc.LinkPublicIpResponse{LinkPublicIpId: ptr.To("eipassoc-foo")}, nil)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "foo"},
+		}}, nil).AnyTimes()
+		mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Eq(osc.UnlinkPublicIpRequest{LinkPublicIpId: ptr.To("eipassoc-foo")})).
+			Return(&osc.UnlinkPublicIpResponse{}, nil)
+		mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("foo")})).
+			Return(&osc.DeletePublicIpResponse{}, nil)
+
+		b := runBatcher(t, mockSDK)
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, err := sdk.AllocateAndLinkIP(ctx, mockSDK, b, sdk.LinkIPOptions{VmID: "i-foo"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestUnlinkAndReleaseIP(t *testing.T) {
+	t.Run("An IP is unlinked and deleted", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		gomock.InOrder(
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "foo", LinkPublicIpId: ptr.To("eipassoc-foo")},
+			}}, nil),
+			mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Eq(osc.UnlinkPublicIpRequest{LinkPublicIpId: ptr.To("eipassoc-foo")})).
+				Return(&osc.UnlinkPublicIpResponse{}, nil),
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "foo"},
+			}}, nil),
+			mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("foo")})).
+				Return(&osc.DeletePublicIpResponse{}, nil),
+		)
+
+		err := sdk.UnlinkAndReleaseIP(t.Context(), mockSDK, runBatcher(t, mockSDK), "foo", "")
+		require.NoError(t, err)
+	})
+	t.Run("An unclaimed pool IP is unlinked before being released", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		pool := []osc.ResourceTag{{Key: tags.PublicIPPool, Value: "pool"}}
+		gomock.InOrder(
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "foo", LinkPublicIpId: ptr.To("eipassoc-foo"), Tags: pool},
+			}}, nil),
+			mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Eq(osc.UnlinkPublicIpRequest{LinkPublicIpId: ptr.To("eipassoc-foo")})).
+				Return(&osc.UnlinkPublicIpResponse{}, nil),
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "foo", Tags: pool},
+			}}, nil),
+			mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Any()).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+				{PublicIpId: "foo", Tags: pool},
+			}}, nil),
+		)
+
+		err := sdk.UnlinkAndReleaseIP(t.Context(), mockSDK, runBatcher(t, mockSDK), "foo", "svc")
+		require.NoError(t, err)
+	})
+	t.Run("A pool IP claimed by another holder is not unlinked", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadPublicIps(gomock.Any(), gomock.Eq(batcherReq("foo"))).Return(&osc.ReadPublicIpsResponse{PublicIps: &[]osc.PublicIp{
+			{PublicIpId: "foo", LinkPublicIpId: ptr.To("eipassoc-foo"), Tags: claimTags("other", time.Now())},
+		}}, nil)
+
+		err := sdk.UnlinkAndReleaseIP(t.Context(), mockSDK, runBatcher(t, mockSDK), "foo", "svc")
+		require.ErrorIs(t, err, sdk.ErrNotClaimed)
+	})
+}
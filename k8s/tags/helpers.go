@@ -6,6 +6,8 @@ SPDX-License-Identifier: BSD-3-Clause
 package tags
 
 import (
+	"strings"
+
 	"github.com/outscale/goutils/k8s/role"
 	"github.com/outscale/goutils/sdk/tags"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
@@ -43,6 +45,26 @@ func HasRole(t []osc.ResourceTag, role role.Role) bool {
 	return tags.Has(t, RoleKey(role))
 }
 
+// RolesOf fetches the roles of a resource. Unknown roles are returned, and may be checked with Validate.
+func RolesOf(t []osc.ResourceTag) role.Set {
+	var roles []role.Role
+	for _, tag := range t {
+		if r, found := strings.CutPrefix(tag.Key, RolePrefix); found {
+			roles = append(roles, role.Role(r))
+		}
+	}
+	return role.NewSet(roles...)
+}
+
+// RoleTags returns the tags defining a set of roles. Role tags have an empty value.
+func RoleTags(roles role.Set) []osc.ResourceTag {
+	res := make([]osc.ResourceTag, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, osc.ResourceTag{Key: RoleKey(r)})
+	}
+	return res
+}
+
 // Wrappers for sdk/tags.
 
 func Has(t []osc.ResourceTag, kv ...string) bool {
@@ -61,6 +83,18 @@ func GetName(t []osc.ResourceTag) (string, bool) {
 	return tags.GetName(t)
 }
 
+func Validate(t []osc.ResourceTag) error {
+	return tags.Validate(t)
+}
+
+func SanitizeKey(s string) (key string, reversible bool) {
+	return tags.SanitizeKey(s)
+}
+
+func SanitizeValue(s string) (value string, reversible bool) {
+	return tags.SanitizeValue(s)
+}
+
 func Must(v string, _ bool) string {
 	return tags.Must(v, true)
 }
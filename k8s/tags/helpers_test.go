@@ -8,9 +8,11 @@ package tags_test
 import (
 	"testing"
 
+	"github.com/outscale/goutils/k8s/role"
 	"github.com/outscale/goutils/k8s/tags"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetClusterID(t *testing.T) {
@@ -43,3 +45,24 @@ func TestImports(t *testing.T) {
 	assert.True(t, tags.Has(tgs, "foo"))
 	assert.Equal(t, "bar", tags.Must(tags.GetValue(tgs, "foo")))
 }
+
+func TestValidate(t *testing.T) {
+	tgs := []osc.ResourceTag{
+		{Key: tags.ClusterIDKey("37286b54-bb4b-46c8-ac8a-37621f1e7123"), Value: string(tags.ResourceLifecycleOwned)},
+		{Key: tags.ServiceName, Value: tags.Must(tags.SanitizeValue("my,service"))},
+	}
+	require.NoError(t, tags.Validate(tgs))
+	require.Error(t, tags.Validate([]osc.ResourceTag{{Key: tags.ClusterIDKey("foo,bar")}}))
+}
+
+func TestRolesOf(t *testing.T) {
+	tgs := []osc.ResourceTag{
+		{Key: "foo", Value: "bar"},
+		{Key: tags.RoleKey(role.Worker)},
+		{Key: tags.RoleKey(role.ControlPlane)},
+	}
+	roles := tags.RolesOf(tgs)
+	assert.Equal(t, role.Set{role.ControlPlane, role.Worker}, roles)
+	assert.ElementsMatch(t, tgs[1:], tags.RoleTags(roles))
+	require.ErrorIs(t, tags.RolesOf([]osc.ResourceTag{{Key: tags.RoleKey("foo")}}).Validate(), role.ErrUnknownRole)
+}
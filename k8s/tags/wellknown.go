@@ -32,6 +32,19 @@ const (
 
 	// PublicIPPool stores the name of a Public IP.
 	PublicIPPool = "OscK8sIPPool"
+	// PublicIPClaim stores the holder of a public IP claimed from a pool.
+	PublicIPClaim = "OscK8sIPClaim"
+	// PublicIPClaimTime stores the time a public IP was claimed from a pool, in RFC3339 format.
+	PublicIPClaimTime = "OscK8sIPClaimTime"
+
+	// CSIVolumeName stores the name of the persistent volume of a volume created by the CSI driver.
+	CSIVolumeName = "CSIVolumeName"
+	// PVName stores the name of the persistent volume of a volume, when extra tags are enabled in the CSI driver.
+	PVName = "kubernetes.io/created-for/pv/name"
+	// PVCName stores the name of the claim of a volume, when extra tags are enabled in the CSI driver.
+	PVCName = "kubernetes.io/created-for/pvc/name"
+	// PVCNamespace stores the namespace of the claim of a volume, when extra tags are enabled in the CSI driver.
+	PVCNamespace = "kubernetes.io/created-for/pvc/namespace"
 )
 
 // ResourceLifecycle is the cluster lifecycle state used in tagging
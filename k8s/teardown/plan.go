@@ -0,0 +1,322 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package teardown
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// Action is an action done on a resource.
+type Action string
+
+// Actions used by teardown plans.
+const (
+	UnlinkPublicIp           Action = "UnlinkPublicIp"
+	DeleteLoadBalancer       Action = "DeleteLoadBalancer"
+	UnlinkNic                Action = "UnlinkNic"
+	DeleteNic                Action = "DeleteNic"
+	DeletePublicIp           Action = "DeletePublicIp"
+	RevokeSecurityGroupRules Action = "RevokeSecurityGroupRules"
+	DeleteSecurityGroup      Action = "DeleteSecurityGroup"
+	DeleteVolume             Action = "DeleteVolume"
+)
+
+// Step is a single action of a teardown plan.
+type Step struct {
+	Action Action
+	Type   osc.TagResourceType
+	ID     string
+	// DependsOn lists the steps that need to succeed before this step.
+	DependsOn []*Step
+
+	run func(ctx context.Context, client osc.ClientInterface) error
+}
+
+func (s *Step) String() string {
+	return string(s.Action) + " " + s.ID
+}
+
+// Blocked is an owned resource that the plan cannot delete.
+type Blocked struct {
+	Type   osc.TagResourceType
+	ID     string
+	Reason string
+}
+
+// Plan is an ordered list of steps. A step only depends on previous steps.
+type Plan struct {
+	ClusterID string
+	Steps     []*Step
+	// Blocked lists the owned resources not deleted by the plan.
+	Blocked []Blocked
+}
+
+func (p *Plan) add(s *Step, deps ...*Step) *Step {
+	for _, dep := range deps {
+		if dep != nil && !slices.Contains(s.DependsOn, dep) {
+			s.DependsOn = append(s.DependsOn, dep)
+		}
+	}
+	p.Steps = append(p.Steps, s)
+	return s
+}
+
+// String returns a human readable version of the plan.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Teardown plan for cluster %s: %d steps\n", p.ClusterID, len(p.Steps))
+	for i, s := range p.Steps {
+		fmt.Fprintf(&sb, "%3d. %s", i+1, s)
+		if len(s.DependsOn) > 0 {
+			deps := make([]string, 0, len(s.DependsOn))
+			for _, dep := range s.DependsOn {
+				deps = append(deps, fmt.Sprint(slices.Index(p.Steps, dep)+1))
+			}
+			fmt.Fprintf(&sb, " (after %s)", strings.Join(deps, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	if len(p.Blocked) > 0 {
+		fmt.Fprintf(&sb, "Blocked resources: %d\n", len(p.Blocked))
+		for _, b := range p.Blocked {
+			fmt.Fprintf(&sb, "  - %s: %s\n", b.ID, b.Reason)
+		}
+	}
+	return sb.String()
+}
+
+func owned(info inventory.Info) bool {
+	return info.Lifecycle == tags.ResourceLifecycleOwned
+}
+
+// NewPlan builds the plan deleting all resources owned by a cluster.
+// Shared resources are never deleted. VMs are not deleted, and block the deletion of their primary NICs, of the
+// security groups of those NICs and VMs, and of their volumes. Blocked resources are reported in Plan.Blocked.
+//
+// Resources are deleted in the following order:
+//   - public IPs are unlinked,
+//   - load balancers are deleted,
+//   - secondary NICs are unlinked and deleted,
+//   - public IPs are deleted,
+//   - security group rules referencing owned security groups are revoked, and security groups are deleted,
+//   - unlinked volumes are deleted.
+func NewPlan(inv *inventory.Inventory) *Plan {
+	p := &Plan{ClusterID: inv.ClusterID}
+
+	// public IPs are unlinked, except those used by load balancers.
+	lbIPs := map[string]*Step{}
+	ipUnlinks := map[string]*Step{}
+	for _, ip := range inv.PublicIps {
+		if !owned(ip.Info) || ip.Object.LinkPublicIpId == nil {
+			continue
+		}
+		if slices.ContainsFunc(inv.LoadBalancers, func(lb inventory.Resource[osc.LoadBalancer]) bool {
+			return ptr.From(lb.Object.PublicIp) == ip.Object.PublicIp
+		}) {
+			continue
+		}
+		linkID := *ip.Object.LinkPublicIpId
+		ipUnlinks[ip.ID] = p.add(&Step{
+			Action: UnlinkPublicIp, Type: ip.Type, ID: ip.ID,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.UnlinkPublicIp(ctx, osc.UnlinkPublicIpRequest{LinkPublicIpId: &linkID})
+				return err
+			},
+		})
+	}
+
+	lbDeletes := map[string]*Step{}
+	for _, lb := range inv.LoadBalancers {
+		if !owned(lb.Info) {
+			continue
+		}
+		name := lb.Object.LoadBalancerName
+		lbDeletes[name] = p.add(&Step{
+			Action: DeleteLoadBalancer, Type: lb.Type, ID: name,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.DeleteLoadBalancer(ctx, osc.DeleteLoadBalancerRequest{LoadBalancerName: name})
+				return err
+			},
+		})
+		if ip := ptr.From(lb.Object.PublicIp); ip != "" {
+			lbIPs[ip] = lbDeletes[name]
+		}
+	}
+
+	nicDeletes := map[string]*Step{}
+	keptSGs := map[string]string{}
+	for _, vm := range inv.Vms {
+		for _, sg := range vm.Object.SecurityGroups {
+			keptSGs[sg.SecurityGroupId] = "used by VM " + vm.ID + ", which is not deleted"
+		}
+	}
+	for _, nic := range inv.Nics {
+		if !owned(nic.Info) {
+			for _, sg := range nic.Object.SecurityGroups {
+				keptSGs[sg.SecurityGroupId] = "used by NIC " + nic.ID + ", which is not deleted"
+			}
+			continue
+		}
+		if nic.Object.LinkNic != nil && nic.Object.LinkNic.DeviceNumber == 0 {
+			// primary NICs are deleted with their VM.
+			reason := "primary NIC of VM " + nic.Object.LinkNic.VmId + ", which is not deleted"
+			p.Blocked = append(p.Blocked, Blocked{Type: nic.Type, ID: nic.ID, Reason: reason})
+			for _, sg := range nic.Object.SecurityGroups {
+				keptSGs[sg.SecurityGroupId] = "used by NIC " + nic.ID + ", the " + reason
+			}
+			continue
+		}
+		var unlink *Step
+		if nic.Object.LinkNic != nil {
+			linkID := nic.Object.LinkNic.LinkNicId
+			unlink = p.add(&Step{
+				Action: UnlinkNic, Type: nic.Type, ID: nic.ID,
+				run: func(ctx context.Context, client osc.ClientInterface) error {
+					_, err := client.UnlinkNic(ctx, osc.UnlinkNicRequest{LinkNicId: linkID})
+					return err
+				},
+			})
+		}
+		var ipUnlink *Step
+		if nic.Object.LinkPublicIp != nil {
+			ipUnlink = ipUnlinks[nic.Object.LinkPublicIp.PublicIpId]
+		}
+		id := nic.ID
+		nicDeletes[id] = p.add(&Step{
+			Action: DeleteNic, Type: nic.Type, ID: id,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.DeleteNic(ctx, osc.DeleteNicRequest{NicId: id})
+				return err
+			},
+		}, unlink, ipUnlink)
+	}
+
+	for _, ip := range inv.PublicIps {
+		if !owned(ip.Info) {
+			continue
+		}
+		id := ip.ID
+		p.add(&Step{
+			Action: DeletePublicIp, Type: ip.Type, ID: id,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.DeletePublicIp(ctx, osc.DeletePublicIpRequest{PublicIpId: &id})
+				return err
+			},
+		}, ipUnlinks[id], lbIPs[ip.Object.PublicIp])
+	}
+
+	var deletedSGs []string
+	for _, sg := range inv.SecurityGroups {
+		if !owned(sg.Info) {
+			continue
+		}
+		if reason, kept := keptSGs[sg.ID]; kept {
+			p.Blocked = append(p.Blocked, Blocked{Type: sg.Type, ID: sg.ID, Reason: reason})
+			continue
+		}
+		deletedSGs = append(deletedSGs, sg.ID)
+	}
+	var revokes []*Step
+	for _, sg := range inv.SecurityGroups {
+		for _, flow := range []struct {
+			name  string
+			rules []osc.SecurityGroupRule
+		}{{"Inbound", sg.Object.InboundRules}, {"Outbound", sg.Object.OutboundRules}} {
+			revoked := referencingRules(flow.rules, sg.ID, deletedSGs)
+			if len(revoked) == 0 {
+				continue
+			}
+			id := sg.ID
+			revokes = append(revokes, p.add(&Step{
+				Action: RevokeSecurityGroupRules, Type: sg.Type, ID: id + "/" + flow.name,
+				run: func(ctx context.Context, client osc.ClientInterface) error {
+					_, err := client.DeleteSecurityGroupRule(ctx, osc.DeleteSecurityGroupRuleRequest{
+						SecurityGroupId: id, Flow: flow.name, Rules: revoked,
+					})
+					return err
+				},
+			}))
+		}
+	}
+	for _, sg := range inv.SecurityGroups {
+		if !slices.Contains(deletedSGs, sg.ID) {
+			continue
+		}
+		id := sg.ID
+		s := &Step{
+			Action: DeleteSecurityGroup, Type: sg.Type, ID: id,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.DeleteSecurityGroup(ctx, osc.DeleteSecurityGroupRequest{SecurityGroupId: &id})
+				return err
+			},
+		}
+		deps := slices.Clone(revokes)
+		for _, lb := range inv.LoadBalancers {
+			if slices.Contains(lb.Object.SecurityGroups, id) {
+				deps = append(deps, lbDeletes[lb.Object.LoadBalancerName])
+			}
+		}
+		for _, nic := range inv.Nics {
+			if slices.ContainsFunc(nic.Object.SecurityGroups, func(l osc.SecurityGroupLight) bool { return l.SecurityGroupId == id }) {
+				deps = append(deps, nicDeletes[nic.ID])
+			}
+		}
+		p.add(s, deps...)
+	}
+
+	for _, vol := range inv.Volumes {
+		if !owned(vol.Info) {
+			continue
+		}
+		if i := slices.IndexFunc(vol.Object.LinkedVolumes, func(l osc.LinkedVolume) bool {
+			return l.State != osc.LinkedVolumeStateDetached
+		}); i >= 0 {
+			// volumes are only unlinked by deleting their VM.
+			reason := "linked to VM " + vol.Object.LinkedVolumes[i].VmId + ", which is not deleted"
+			p.Blocked = append(p.Blocked, Blocked{Type: vol.Type, ID: vol.ID, Reason: reason})
+			continue
+		}
+		id := vol.ID
+		p.add(&Step{
+			Action: DeleteVolume, Type: vol.Type, ID: id,
+			run: func(ctx context.Context, client osc.ClientInterface) error {
+				_, err := client.DeleteVolume(ctx, osc.DeleteVolumeRequest{VolumeId: id})
+				return err
+			},
+		})
+	}
+	return p
+}
+
+// referencingRules returns the parts of rules referencing security groups to delete, other than sgID.
+func referencingRules(rules []osc.SecurityGroupRule, sgID string, deleted []string) []osc.SecurityGroupRule {
+	var res []osc.SecurityGroupRule
+	for _, rule := range rules {
+		var members []osc.SecurityGroupsMember
+		for _, m := range rule.SecurityGroupsMembers {
+			if m.SecurityGroupId != sgID && slices.Contains(deleted, m.SecurityGroupId) {
+				members = append(members, m)
+			}
+		}
+		if len(members) > 0 {
+			res = append(res, osc.SecurityGroupRule{
+				IpProtocol:            rule.IpProtocol,
+				FromPortRange:         rule.FromPortRange,
+				ToPortRange:           rule.ToPortRange,
+				SecurityGroupsMembers: members,
+			})
+		}
+	}
+	return res
+}
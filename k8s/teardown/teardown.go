@@ -0,0 +1,154 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package teardown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultRetryInterval is the default wait between two attempts of a step.
+	DefaultRetryInterval = 5 * time.Second
+	// DefaultMaxAttempts is the default max number of attempts of a step.
+	DefaultMaxAttempts = 12
+)
+
+// ErrDependencyFailed is returned for steps skipped because a dependency has failed.
+var ErrDependencyFailed = errors.New("dependency failed")
+
+// Status is the status of a step.
+type Status string
+
+const (
+	// StatusPlanned is sent in dry-run mode.
+	StatusPlanned Status = "planned"
+	// StatusStarted is sent before the first attempt.
+	StatusStarted Status = "started"
+	// StatusRetrying is sent when an attempt failed with a retryable error.
+	StatusRetrying Status = "retrying"
+	// StatusDone is sent when the step succeeded.
+	StatusDone Status = "done"
+	// StatusFailed is sent when the step failed.
+	StatusFailed Status = "failed"
+	// StatusSkipped is sent when a dependency has failed.
+	StatusSkipped Status = "skipped"
+)
+
+// Event is a progress event.
+type Event struct {
+	Step    *Step
+	Index   int
+	Total   int
+	Status  Status
+	Attempt int
+	Err     error
+}
+
+// Options defines the teardown options.
+type Options struct {
+	// DryRun only reports the plan, without calling the API.
+	DryRun bool
+	// RetryInterval is the wait between two attempts of a step. Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+	// MaxAttempts is the max number of attempts of a step. Defaults to DefaultMaxAttempts.
+	MaxAttempts int
+	// Progress is called for each step status change.
+	Progress func(Event)
+}
+
+// IsRetryable checks if an error is a dependency violation or an in-use error,
+// that may be solved by waiting for other deletions to complete.
+func IsRetryable(err error) bool {
+	return osc.IsConflict(err)
+}
+
+// Teardown deletes all resources owned by a cluster, and returns the executed plan.
+func Teardown(ctx context.Context, client osc.ClientInterface, clusterID string, opts Options) (*Plan, error) {
+	inv, err := inventory.Read(ctx, client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("teardown: %w", err)
+	}
+	plan := NewPlan(inv)
+	return plan, plan.Execute(ctx, client, opts)
+}
+
+// Execute runs all steps of a plan, in order.
+// Steps whose dependencies have failed are skipped, other steps are still run.
+// Not found errors are ignored, and retryable errors are retried.
+func (p *Plan) Execute(ctx context.Context, client osc.ClientInterface, opts Options) error {
+	if opts.RetryInterval == 0 {
+		opts.RetryInterval = DefaultRetryInterval
+	}
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	logger := klog.FromContext(ctx).WithValues("clusterID", p.ClusterID)
+	progress := func(e Event) {
+		e.Total = len(p.Steps)
+		logger.V(3).Info("Teardown step "+string(e.Status), "step", e.Step.String(), "attempt", e.Attempt, "err", e.Err)
+		if opts.Progress != nil {
+			opts.Progress(e)
+		}
+	}
+	failed := map[*Step]bool{}
+	var errs []error
+STEPS:
+	for i, s := range p.Steps {
+		if opts.DryRun {
+			progress(Event{Step: s, Index: i, Status: StatusPlanned})
+			continue
+		}
+		for _, dep := range s.DependsOn {
+			if failed[dep] {
+				failed[s] = true
+				err := fmt.Errorf("%s: %w: %s", s, ErrDependencyFailed, dep)
+				progress(Event{Step: s, Index: i, Status: StatusSkipped, Err: err})
+				errs = append(errs, err)
+				continue STEPS
+			}
+		}
+		progress(Event{Step: s, Index: i, Status: StatusStarted, Attempt: 1})
+		if err := p.run(ctx, client, s, i, opts, progress); err != nil {
+			failed[s] = true
+			progress(Event{Step: s, Index: i, Status: StatusFailed, Err: err})
+			errs = append(errs, fmt.Errorf("%s: %w", s, err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		progress(Event{Step: s, Index: i, Status: StatusDone})
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("teardown: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (p *Plan) run(ctx context.Context, client osc.ClientInterface, s *Step, i int, opts Options, progress func(Event)) error {
+	for attempt := 1; ; attempt++ {
+		err := s.run(ctx, client)
+		switch {
+		case err == nil || osc.IsNotFound(err):
+			return nil
+		case !IsRetryable(err) || attempt >= opts.MaxAttempts:
+			return err
+		}
+		progress(Event{Step: s, Index: i, Status: StatusRetrying, Attempt: attempt + 1, Err: err})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(opts.RetryInterval):
+		}
+	}
+}
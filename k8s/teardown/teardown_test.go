@@ -0,0 +1,183 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package teardown_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/k8s/teardown"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func resource[T any](id string, typ osc.TagResourceType, lifecycle tags.ResourceLifecycle, obj T) inventory.Resource[T] {
+	return inventory.Resource[T]{Info: inventory.Info{ID: id, Type: typ, Lifecycle: lifecycle}, Object: obj}
+}
+
+func testInventory() *inventory.Inventory {
+	return &inventory.Inventory{
+		ClusterID: "foo",
+		PublicIps: []inventory.Resource[osc.PublicIp]{
+			resource("eipalloc-vm", osc.TagResourceTypePublicIp, tags.ResourceLifecycleOwned,
+				osc.PublicIp{PublicIpId: "eipalloc-vm", PublicIp: "1.1.1.1", LinkPublicIpId: ptr.To("eipassoc-vm")}),
+			resource("eipalloc-lb", osc.TagResourceTypePublicIp, tags.ResourceLifecycleOwned,
+				osc.PublicIp{PublicIpId: "eipalloc-lb", PublicIp: "2.2.2.2", LinkPublicIpId: ptr.To("eipassoc-lb")}),
+			resource("eipalloc-shared", osc.TagResourceTypePublicIp, tags.ResourceLifecycleShared,
+				osc.PublicIp{PublicIpId: "eipalloc-shared", PublicIp: "3.3.3.3", LinkPublicIpId: ptr.To("eipassoc-shared")}),
+		},
+		LoadBalancers: []inventory.Resource[osc.LoadBalancer]{
+			resource("lb-foo", inventory.TagResourceTypeLoadBalancer, tags.ResourceLifecycleOwned,
+				osc.LoadBalancer{LoadBalancerName: "lb-foo", PublicIp: ptr.To("2.2.2.2"), SecurityGroups: []string{"sg-lb"}}),
+		},
+		SecurityGroups: []inventory.Resource[osc.SecurityGroup]{
+			resource("sg-lb", osc.TagResourceTypeSecurityGroup, tags.ResourceLifecycleOwned, osc.SecurityGroup{SecurityGroupId: "sg-lb"}),
+			resource("sg-node", osc.TagResourceTypeSecurityGroup, tags.ResourceLifecycleShared, osc.SecurityGroup{
+				SecurityGroupId: "sg-node",
+				InboundRules: []osc.SecurityGroupRule{{
+					IpProtocol: "tcp", FromPortRange: 80, ToPortRange: 80, IpRanges: []string{"10.0.0.0/16"},
+					SecurityGroupsMembers: []osc.SecurityGroupsMember{{SecurityGroupId: "sg-lb"}, {SecurityGroupId: "sg-node"}},
+				}},
+			}),
+		},
+	}
+}
+
+func TestNewPlan(t *testing.T) {
+	plan := teardown.NewPlan(testInventory())
+	assert.Equal(t, `Teardown plan for cluster foo: 6 steps
+  1. UnlinkPublicIp eipalloc-vm
+  2. DeleteLoadBalancer lb-foo
+  3. DeletePublicIp eipalloc-vm (after 1)
+  4. DeletePublicIp eipalloc-lb (after 2)
+  5. RevokeSecurityGroupRules sg-node/Inbound
+  6. DeleteSecurityGroup sg-lb (after 5, 2)
+`, plan.String())
+}
+
+func TestExecute(t *testing.T) {
+	conflict := &osc.ErrorResponse{Errors: []osc.Errors{{Code: "9029", Type: "ResourceConflict"}}}
+	notFound := &osc.ErrorResponse{Errors: []osc.Errors{{Code: "5024", Type: "InvalidResource"}}}
+	t.Run("Steps are run in order, retrying conflicts and ignoring not found errors", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		gomock.InOrder(
+			mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Eq(osc.UnlinkPublicIpRequest{LinkPublicIpId: ptr.To("eipassoc-vm")})).
+				Return(&osc.UnlinkPublicIpResponse{}, nil),
+			mockSDK.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Eq(osc.DeleteLoadBalancerRequest{LoadBalancerName: "lb-foo"})).
+				Return(&osc.DeleteLoadBalancerResponse{}, nil),
+			mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("eipalloc-vm")})).
+				Return(nil, notFound),
+			mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("eipalloc-lb")})).
+				Return(nil, conflict),
+			mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Eq(osc.DeletePublicIpRequest{PublicIpId: ptr.To("eipalloc-lb")})).
+				Return(&osc.DeletePublicIpResponse{}, nil),
+			mockSDK.EXPECT().DeleteSecurityGroupRule(gomock.Any(), gomock.Eq(osc.DeleteSecurityGroupRuleRequest{
+				SecurityGroupId: "sg-node", Flow: "Inbound",
+				Rules: []osc.SecurityGroupRule{{
+					IpProtocol: "tcp", FromPortRange: 80, ToPortRange: 80,
+					SecurityGroupsMembers: []osc.SecurityGroupsMember{{SecurityGroupId: "sg-lb"}},
+				}},
+			})).Return(&osc.DeleteSecurityGroupRuleResponse{}, nil),
+			mockSDK.EXPECT().DeleteSecurityGroup(gomock.Any(), gomock.Eq(osc.DeleteSecurityGroupRequest{SecurityGroupId: ptr.To("sg-lb")})).
+				Return(&osc.DeleteSecurityGroupResponse{}, nil),
+		)
+		var events []teardown.Event
+		err := teardown.NewPlan(testInventory()).Execute(t.Context(), mockSDK, teardown.Options{
+			RetryInterval: time.Millisecond,
+			Progress:      func(e teardown.Event) { events = append(events, e) },
+		})
+		require.NoError(t, err)
+		assert.Len(t, events, 13)
+		assert.Equal(t, teardown.StatusRetrying, events[7].Status)
+	})
+	t.Run("Steps depending on a failed step are skipped", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().UnlinkPublicIp(gomock.Any(), gomock.Any()).Return(&osc.UnlinkPublicIpResponse{}, nil)
+		mockSDK.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Any()).Return(nil, conflict).Times(2)
+		mockSDK.EXPECT().DeletePublicIp(gomock.Any(), gomock.Any()).Return(&osc.DeletePublicIpResponse{}, nil)
+		mockSDK.EXPECT().DeleteSecurityGroupRule(gomock.Any(), gomock.Any()).Return(&osc.DeleteSecurityGroupRuleResponse{}, nil)
+
+		err := teardown.NewPlan(testInventory()).Execute(t.Context(), mockSDK, teardown.Options{
+			RetryInterval: time.Millisecond,
+			MaxAttempts:   2,
+		})
+		require.ErrorIs(t, err, teardown.ErrDependencyFailed)
+		var oerr *osc.ErrorResponse
+		require.ErrorAs(t, err, &oerr)
+	})
+	t.Run("Nothing is called in dry-run mode", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		var events []teardown.Event
+		err := teardown.NewPlan(testInventory()).Execute(t.Context(), mockSDK, teardown.Options{
+			DryRun:   true,
+			Progress: func(e teardown.Event) { events = append(events, e) },
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 6)
+		for _, e := range events {
+			assert.Equal(t, teardown.StatusPlanned, e.Status)
+		}
+	})
+}
+
+func TestNewPlan_PrimaryNics(t *testing.T) {
+	sgs := []osc.SecurityGroupLight{{SecurityGroupId: "sg-node"}}
+	inv := &inventory.Inventory{
+		ClusterID: "foo",
+		Nics: []inventory.Resource[osc.Nic]{
+			resource("eni-primary", osc.TagResourceTypeNic, tags.ResourceLifecycleOwned, osc.Nic{
+				NicId: "eni-primary", SecurityGroups: sgs,
+				LinkNic: &osc.LinkNic{LinkNicId: "eni-attach-primary", VmId: "i-foo", DeviceNumber: 0},
+			}),
+			resource("eni-secondary", osc.TagResourceTypeNic, tags.ResourceLifecycleOwned, osc.Nic{
+				NicId: "eni-secondary", SecurityGroups: []osc.SecurityGroupLight{{SecurityGroupId: "sg-extra"}},
+				LinkNic: &osc.LinkNic{LinkNicId: "eni-attach-secondary", VmId: "i-foo", DeviceNumber: 1},
+			}),
+		},
+		SecurityGroups: []inventory.Resource[osc.SecurityGroup]{
+			resource("sg-node", osc.TagResourceTypeSecurityGroup, tags.ResourceLifecycleOwned, osc.SecurityGroup{SecurityGroupId: "sg-node"}),
+			resource("sg-extra", osc.TagResourceTypeSecurityGroup, tags.ResourceLifecycleOwned, osc.SecurityGroup{SecurityGroupId: "sg-extra"}),
+		},
+	}
+	plan := teardown.NewPlan(inv)
+	assert.Equal(t, `Teardown plan for cluster foo: 3 steps
+  1. UnlinkNic eni-secondary
+  2. DeleteNic eni-secondary (after 1)
+  3. DeleteSecurityGroup sg-extra (after 2)
+Blocked resources: 2
+  - eni-primary: primary NIC of VM i-foo, which is not deleted
+  - sg-node: used by NIC eni-primary, the primary NIC of VM i-foo, which is not deleted
+`, plan.String())
+}
+
+func TestNewPlan_Volumes(t *testing.T) {
+	inv := &inventory.Inventory{
+		ClusterID: "foo",
+		Volumes: []inventory.Resource[osc.Volume]{
+			resource("vol-linked", osc.TagResourceTypeVolume, tags.ResourceLifecycleOwned, osc.Volume{
+				VolumeId:      "vol-linked",
+				LinkedVolumes: []osc.LinkedVolume{{VmId: "i-foo", VolumeId: "vol-linked", State: osc.LinkedVolumeStateAttached}},
+			}),
+			resource("vol-detached", osc.TagResourceTypeVolume, tags.ResourceLifecycleOwned, osc.Volume{VolumeId: "vol-detached"}),
+			resource("vol-shared", osc.TagResourceTypeVolume, tags.ResourceLifecycleShared, osc.Volume{VolumeId: "vol-shared"}),
+		},
+	}
+	plan := teardown.NewPlan(inv)
+	assert.Equal(t, `Teardown plan for cluster foo: 1 steps
+  1. DeleteVolume vol-detached
+Blocked resources: 1
+  - vol-linked: linked to VM i-foo, which is not deleted
+`, plan.String())
+}
@@ -0,0 +1,233 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package topology
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/outscale/goutils/k8s/inventory"
+	"github.com/outscale/goutils/k8s/role"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/sdk/ptr"
+	sdktags "github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// ErrNetNotFound is returned when no Net is tagged with the cluster ID.
+	ErrNetNotFound = errors.New("no net found")
+	// ErrMissingRole is returned when no resource has a role.
+	ErrMissingRole = errors.New("missing role")
+	// ErrAmbiguous is returned when multiple resources match, and only one is expected.
+	ErrAmbiguous = errors.New("ambiguous match")
+)
+
+// Topology is the network topology of a cluster, resolved using role and cluster tags.
+// Resources having multiple roles are listed under each role, resources without role are not listed by role.
+type Topology struct {
+	ClusterID string
+	Net       inventory.Resource[osc.Net]
+	// Subnets lists subnets by role and subregion.
+	Subnets map[role.Role]map[string][]inventory.Resource[osc.Subnet]
+	// SecurityGroups lists security groups by role.
+	SecurityGroups map[role.Role][]inventory.Resource[osc.SecurityGroup]
+	RouteTables    []inventory.Resource[osc.RouteTable]
+	NatServices    []inventory.Resource[osc.NatService]
+}
+
+// Discover fetches the network topology of a cluster.
+// A single Net needs to be tagged with the cluster ID, subnets, security groups, route tables and NAT services
+// need to be tagged with the cluster ID and be within the Net.
+func Discover(ctx context.Context, client osc.ClientInterface, clusterID string) (*Topology, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(4).Info("Discovering cluster topology", "clusterID", clusterID)
+	filter := sdktags.NewFilter().Exists(tags.ClusterIDKey(clusterID))
+
+	nets, err := readAll(func(token *string) ([]osc.Net, *string, error) {
+		resp, err := client.ReadNets(ctx, osc.ReadNetsRequest{Filters: sdktags.Filters[osc.FiltersNet](filter), NextPageToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("read nets: %w", err)
+		}
+		return ptr.From(resp.Nets), resp.NextPageToken, nil
+	})
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("discover topology: %w", err)
+	case len(nets) == 0:
+		return nil, fmt.Errorf("discover topology: %w for cluster %s", ErrNetNotFound, clusterID)
+	case len(nets) > 1:
+		return nil, fmt.Errorf("discover topology: %w: %d nets found for cluster %s", ErrAmbiguous, len(nets), clusterID)
+	}
+	net := nets[0]
+	netIDs := []string{net.NetId}
+	topo := &Topology{
+		ClusterID:      clusterID,
+		Net:            resource(net.NetId, osc.TagResourceTypeNet, clusterID, net.Tags, net),
+		Subnets:        map[role.Role]map[string][]inventory.Resource[osc.Subnet]{},
+		SecurityGroups: map[role.Role][]inventory.Resource[osc.SecurityGroup]{},
+	}
+
+	subnets, err := readAll(func(token *string) ([]osc.Subnet, *string, error) {
+		filters := &osc.FiltersSubnet{NetIds: &netIDs}
+		sdktags.ApplyFilter(filters, filter)
+		resp, err := client.ReadSubnets(ctx, osc.ReadSubnetsRequest{Filters: filters, NextPageToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("read subnets: %w", err)
+		}
+		return ptr.From(resp.Subnets), resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discover topology: %w", err)
+	}
+	for _, subnet := range subnets {
+		r := resource(subnet.SubnetId, osc.TagResourceTypeSubnet, clusterID, subnet.Tags, subnet)
+		for _, rl := range r.Roles {
+			if topo.Subnets[rl] == nil {
+				topo.Subnets[rl] = map[string][]inventory.Resource[osc.Subnet]{}
+			}
+			topo.Subnets[rl][subnet.SubregionName] = append(topo.Subnets[rl][subnet.SubregionName], r)
+		}
+	}
+
+	sgs, err := readAll(func(token *string) ([]osc.SecurityGroup, *string, error) {
+		filters := &osc.FiltersSecurityGroup{NetIds: &netIDs}
+		sdktags.ApplyFilter(filters, filter)
+		resp, err := client.ReadSecurityGroups(ctx, osc.ReadSecurityGroupsRequest{Filters: filters, NextPageToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("read security groups: %w", err)
+		}
+		return ptr.From(resp.SecurityGroups), resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discover topology: %w", err)
+	}
+	for _, sg := range sgs {
+		r := resource(sg.SecurityGroupId, osc.TagResourceTypeSecurityGroup, clusterID, sg.Tags, sg)
+		for _, rl := range r.Roles {
+			topo.SecurityGroups[rl] = append(topo.SecurityGroups[rl], r)
+		}
+	}
+
+	rtbs, err := readAll(func(token *string) ([]osc.RouteTable, *string, error) {
+		filters := &osc.FiltersRouteTable{NetIds: &netIDs}
+		sdktags.ApplyFilter(filters, filter)
+		resp, err := client.ReadRouteTables(ctx, osc.ReadRouteTablesRequest{Filters: filters, NextPageToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("read route tables: %w", err)
+		}
+		return ptr.From(resp.RouteTables), resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discover topology: %w", err)
+	}
+	for _, rtb := range rtbs {
+		topo.RouteTables = append(topo.RouteTables, resource(rtb.RouteTableId, osc.TagResourceTypeRouteTable, clusterID, rtb.Tags, rtb))
+	}
+
+	nats, err := readAll(func(token *string) ([]osc.NatService, *string, error) {
+		filters := &osc.FiltersNatService{NetIds: &netIDs}
+		sdktags.ApplyFilter(filters, filter)
+		resp, err := client.ReadNatServices(ctx, osc.ReadNatServicesRequest{Filters: filters, NextPageToken: token})
+		if err != nil {
+			return nil, nil, fmt.Errorf("read nat services: %w", err)
+		}
+		return ptr.From(resp.NatServices), resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discover topology: %w", err)
+	}
+	for _, nat := range nats {
+		topo.NatServices = append(topo.NatServices, resource(nat.NatServiceId, osc.TagResourceTypeNatServiceOrNetAccessPoint, clusterID, nat.Tags, nat))
+	}
+	logger.V(4).Info("Cluster topology discovered", "clusterID", clusterID, "netID", net.NetId,
+		"subnets", len(subnets), "securityGroups", len(sgs), "routeTables", len(rtbs), "natServices", len(nats))
+	return topo, nil
+}
+
+// Subregions returns the sorted subregions having a subnet with a role.
+func (t *Topology) Subregions(r role.Role) []string {
+	return slices.Sorted(maps.Keys(t.Subnets[r]))
+}
+
+// Subnet returns the subnet having a role in a subregion.
+// Roles are tried in order, the first role having a subnet in the subregion is used.
+// ErrMissingRole is returned if no role has a subnet, and ErrAmbiguous if the first matching role has multiple subnets.
+func (t *Topology) Subnet(subregion string, roles ...role.Role) (inventory.Resource[osc.Subnet], error) {
+	for _, r := range roles {
+		switch subnets := t.Subnets[r][subregion]; len(subnets) {
+		case 0:
+			continue
+		case 1:
+			return subnets[0], nil
+		default:
+			return inventory.Resource[osc.Subnet]{}, fmt.Errorf("%w: %d subnets with role %s in %s", ErrAmbiguous, len(subnets), r, subregion)
+		}
+	}
+	return inventory.Resource[osc.Subnet]{}, fmt.Errorf("%w: no subnet with role %v in %s", ErrMissingRole, roles, subregion)
+}
+
+// SecurityGroup returns the security group having a role.
+// Roles are tried in order, the first role having a security group is used.
+// ErrMissingRole is returned if no role has a security group, and ErrAmbiguous if the first matching role has multiple security groups.
+func (t *Topology) SecurityGroup(roles ...role.Role) (inventory.Resource[osc.SecurityGroup], error) {
+	for _, r := range roles {
+		switch sgs := t.SecurityGroups[r]; len(sgs) {
+		case 0:
+			continue
+		case 1:
+			return sgs[0], nil
+		default:
+			return inventory.Resource[osc.SecurityGroup]{}, fmt.Errorf("%w: %d security groups with role %s", ErrAmbiguous, len(sgs), r)
+		}
+	}
+	return inventory.Resource[osc.SecurityGroup]{}, fmt.Errorf("%w: no security group with role %v", ErrMissingRole, roles)
+}
+
+// Validate checks that all required roles are found on a subnet or a security group,
+// and that no subregion has multiple subnets with the same role.
+func (t *Topology) Validate(required ...role.Role) error {
+	var errs []error
+	for _, r := range required {
+		if len(t.Subnets[r]) == 0 && len(t.SecurityGroups[r]) == 0 {
+			errs = append(errs, fmt.Errorf("%w: no subnet or security group with role %s", ErrMissingRole, r))
+		}
+	}
+	for _, r := range slices.Sorted(maps.Keys(t.Subnets)) {
+		for _, subregion := range t.Subregions(r) {
+			if subnets := t.Subnets[r][subregion]; len(subnets) > 1 {
+				errs = append(errs, fmt.Errorf("%w: %d subnets with role %s in %s", ErrAmbiguous, len(subnets), r, subregion))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func resource[T any](id string, typ osc.TagResourceType, clusterID string, t []osc.ResourceTag, obj T) inventory.Resource[T] {
+	return inventory.Resource[T]{Info: inventory.NewInfo(id, typ, clusterID, t), Object: obj}
+}
+
+func readAll[T any](read func(token *string) ([]T, *string, error)) ([]T, error) {
+	var (
+		res   []T
+		token *string
+	)
+	for {
+		page, next, err := read(token)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page...)
+		if ptr.From(next) == "" {
+			return res, nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,91 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package topology_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/k8s/role"
+	"github.com/outscale/goutils/k8s/tags"
+	"github.com/outscale/goutils/k8s/topology"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const clusterID = "37286b54-bb4b-46c8-ac8a-37621f1e7123"
+
+func withRoles(roles ...role.Role) []osc.ResourceTag {
+	t := []osc.ResourceTag{{Key: tags.ClusterIDKey(clusterID), Value: string(tags.ResourceLifecycleShared)}}
+	for _, r := range roles {
+		t = append(t, osc.ResourceTag{Key: tags.RoleKey(r), Value: "1"})
+	}
+	return t
+}
+
+func TestDiscover(t *testing.T) {
+	tagKeys := &[]string{tags.ClusterIDKey(clusterID)}
+	netIDs := &[]string{"vpc-foo"}
+	t.Run("The topology is discovered", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadNets(gomock.Any(), gomock.Eq(osc.ReadNetsRequest{Filters: &osc.FiltersNet{TagKeys: tagKeys}})).
+			Return(&osc.ReadNetsResponse{Nets: &[]osc.Net{{NetId: "vpc-foo", Tags: withRoles()}}}, nil)
+		mockSDK.EXPECT().ReadSubnets(gomock.Any(), gomock.Eq(osc.ReadSubnetsRequest{Filters: &osc.FiltersSubnet{NetIds: netIDs, TagKeys: tagKeys}})).
+			Return(&osc.ReadSubnetsResponse{Subnets: &[]osc.Subnet{
+				{SubnetId: "subnet-a", SubregionName: "eu-west-2a", Tags: withRoles(role.Worker, role.ControlPlane)},
+				{SubnetId: "subnet-b", SubregionName: "eu-west-2b", Tags: withRoles(role.Worker)},
+				{SubnetId: "subnet-lb", SubregionName: "eu-west-2a", Tags: withRoles(role.LoadBalancer)},
+				{SubnetId: "subnet-svc1", SubregionName: "eu-west-2a", Tags: withRoles(role.Service)},
+				{SubnetId: "subnet-svc2", SubregionName: "eu-west-2a", Tags: withRoles(role.Service)},
+			}}, nil)
+		mockSDK.EXPECT().ReadSecurityGroups(gomock.Any(), gomock.Eq(osc.ReadSecurityGroupsRequest{Filters: &osc.FiltersSecurityGroup{NetIds: netIDs, TagKeys: tagKeys}})).
+			Return(&osc.ReadSecurityGroupsResponse{SecurityGroups: &[]osc.SecurityGroup{
+				{SecurityGroupId: "sg-worker", Tags: withRoles(role.Worker)},
+			}}, nil)
+		mockSDK.EXPECT().ReadRouteTables(gomock.Any(), gomock.Eq(osc.ReadRouteTablesRequest{Filters: &osc.FiltersRouteTable{NetIds: netIDs, TagKeys: tagKeys}})).
+			Return(&osc.ReadRouteTablesResponse{RouteTables: &[]osc.RouteTable{{RouteTableId: "rtb-foo", Tags: withRoles()}}}, nil)
+		mockSDK.EXPECT().ReadNatServices(gomock.Any(), gomock.Eq(osc.ReadNatServicesRequest{Filters: &osc.FiltersNatService{NetIds: netIDs, TagKeys: tagKeys}})).
+			Return(&osc.ReadNatServicesResponse{}, nil)
+
+		topo, err := topology.Discover(t.Context(), mockSDK, clusterID)
+		require.NoError(t, err)
+		assert.Equal(t, "vpc-foo", topo.Net.ID)
+		assert.Equal(t, []string{"eu-west-2a", "eu-west-2b"}, topo.Subregions(role.Worker))
+		require.Len(t, topo.RouteTables, 1)
+		assert.Empty(t, topo.NatServices)
+
+		subnet, err := topo.Subnet("eu-west-2a", role.InternalService, role.LoadBalancer)
+		require.NoError(t, err)
+		assert.Equal(t, "subnet-lb", subnet.ID)
+		_, err = topo.Subnet("eu-west-2a", role.Service, role.LoadBalancer)
+		require.ErrorIs(t, err, topology.ErrAmbiguous)
+		_, err = topo.Subnet("eu-west-2b", role.ControlPlane)
+		require.ErrorIs(t, err, topology.ErrMissingRole)
+		sg, err := topo.SecurityGroup(role.Worker)
+		require.NoError(t, err)
+		assert.Equal(t, "sg-worker", sg.ID)
+
+		err = topo.Validate(role.Worker, role.Bastion)
+		require.ErrorIs(t, err, topology.ErrMissingRole)
+		require.ErrorIs(t, err, topology.ErrAmbiguous)
+		assert.Equal(t, "missing role: no subnet or security group with role bastion\n"+
+			"ambiguous match: 2 subnets with role service in eu-west-2a", err.Error())
+	})
+	t.Run("A single net is expected", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadNets(gomock.Any(), gomock.Any()).Return(&osc.ReadNetsResponse{}, nil)
+		_, err := topology.Discover(t.Context(), mockSDK, clusterID)
+		require.ErrorIs(t, err, topology.ErrNetNotFound)
+
+		mockSDK.EXPECT().ReadNets(gomock.Any(), gomock.Any()).Return(&osc.ReadNetsResponse{Nets: &[]osc.Net{{NetId: "vpc-foo"}, {NetId: "vpc-bar"}}}, nil)
+		_, err = topology.Discover(t.Context(), mockSDK, clusterID)
+		require.ErrorIs(t, err, topology.ErrAmbiguous)
+	})
+}
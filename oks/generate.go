@@ -11,3 +11,5 @@ import (
 //go:generate go run k8s.io/code-generator/cmd/deepcopy-gen github.com/outscale/goutils/oks/apis/oks.dev/v1beta --output-file zz_generated.deepcopy.go --go-header-file hack/boilerplate.go.txt
 
 //go:generate go run k8s.io/code-generator/cmd/client-gen --input-base /home/outscale/go/src/github.com/outscale/goutils/oks/apis --output-dir . --output-pkg github.com/outscale/goutils/oks --clientset-name clientset --input oks.dev/v1beta2 --input oks.dev/v1beta --go-header-file hack/boilerplate.go.txt
+//go:generate go run k8s.io/code-generator/cmd/lister-gen github.com/outscale/goutils/oks/apis/oks.dev/v1beta2 github.com/outscale/goutils/oks/apis/oks.dev/v1beta --output-dir listers --output-pkg github.com/outscale/goutils/oks/listers --go-header-file hack/boilerplate.go.txt
+//go:generate go run k8s.io/code-generator/cmd/informer-gen github.com/outscale/goutils/oks/apis/oks.dev/v1beta2 github.com/outscale/goutils/oks/apis/oks.dev/v1beta --versioned-clientset-package github.com/outscale/goutils/oks/clientset --listers-package github.com/outscale/goutils/oks/listers --output-dir informers --output-pkg github.com/outscale/goutils/oks/informers --go-header-file hack/boilerplate.go.txt
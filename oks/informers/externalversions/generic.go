@@ -0,0 +1,67 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package externalversions
+
+import (
+	fmt "fmt"
+
+	v1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	v1beta2 "github.com/outscale/goutils/oks/apis/oks.dev/v1beta2"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// GenericInformer is type of SharedIndexInformer which will locate and delegate to other
+// sharedInformers based on type
+type GenericInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() cache.GenericLister
+}
+
+type genericInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupResource
+}
+
+// Informer returns the SharedIndexInformer.
+func (f *genericInformer) Informer() cache.SharedIndexInformer {
+	return f.informer
+}
+
+// Lister returns the GenericLister.
+func (f *genericInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(f.Informer().GetIndexer(), f.resource)
+}
+
+// ForResource gives generic access to a shared informer of the matching type
+// TODO extend this to unknown resources with a client pool
+func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
+	switch resource {
+	// Group=oks.dev, Version=v1beta
+	case v1beta.SchemeGroupVersion.WithResource("ippools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().IPPools().Informer()}, nil
+	case v1beta.SchemeGroupVersion.WithResource("netpeerings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().NetPeerings().Informer()}, nil
+	case v1beta.SchemeGroupVersion.WithResource("netpeeringacceptances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().NetPeeringAcceptances().Informer()}, nil
+	case v1beta.SchemeGroupVersion.WithResource("netpeeringrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().NetPeeringRequests().Informer()}, nil
+	case v1beta.SchemeGroupVersion.WithResource("oosaccesses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().OOSAccesses().Informer()}, nil
+	case v1beta.SchemeGroupVersion.WithResource("vpnconnections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta().VpnConnections().Informer()}, nil
+
+		// Group=oks.dev, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithResource("nodepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Oks().V1beta2().NodePools().Informer()}, nil
+
+	}
+
+	return nil, fmt.Errorf("no informer found for %v", resource)
+}
@@ -0,0 +1,44 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package oks
+
+import (
+	internalinterfaces "github.com/outscale/goutils/oks/informers/externalversions/internalinterfaces"
+	v1beta "github.com/outscale/goutils/oks/informers/externalversions/oks.dev/v1beta"
+	v1beta2 "github.com/outscale/goutils/oks/informers/externalversions/oks.dev/v1beta2"
+)
+
+// Interface provides access to each of this group's versions.
+type Interface interface {
+	// V1beta provides access to shared informers for resources in V1beta.
+	V1beta() v1beta.Interface
+	// V1beta2 provides access to shared informers for resources in V1beta2.
+	V1beta2() v1beta2.Interface
+}
+
+type group struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &group{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// V1beta returns a new v1beta.Interface.
+func (g *group) V1beta() v1beta.Interface {
+	return v1beta.New(g.factory, g.namespace, g.tweakListOptions)
+}
+
+// V1beta2 returns a new v1beta2.Interface.
+func (g *group) V1beta2() v1beta2.Interface {
+	return v1beta2.New(g.factory, g.namespace, g.tweakListOptions)
+}
@@ -0,0 +1,70 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	internalinterfaces "github.com/outscale/goutils/oks/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// IPPools returns a IPPoolInformer.
+	IPPools() IPPoolInformer
+	// NetPeerings returns a NetPeeringInformer.
+	NetPeerings() NetPeeringInformer
+	// NetPeeringAcceptances returns a NetPeeringAcceptanceInformer.
+	NetPeeringAcceptances() NetPeeringAcceptanceInformer
+	// NetPeeringRequests returns a NetPeeringRequestInformer.
+	NetPeeringRequests() NetPeeringRequestInformer
+	// OOSAccesses returns a OOSAccessInformer.
+	OOSAccesses() OOSAccessInformer
+	// VpnConnections returns a VpnConnectionInformer.
+	VpnConnections() VpnConnectionInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// IPPools returns a IPPoolInformer.
+func (v *version) IPPools() IPPoolInformer {
+	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NetPeerings returns a NetPeeringInformer.
+func (v *version) NetPeerings() NetPeeringInformer {
+	return &netPeeringInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NetPeeringAcceptances returns a NetPeeringAcceptanceInformer.
+func (v *version) NetPeeringAcceptances() NetPeeringAcceptanceInformer {
+	return &netPeeringAcceptanceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NetPeeringRequests returns a NetPeeringRequestInformer.
+func (v *version) NetPeeringRequests() NetPeeringRequestInformer {
+	return &netPeeringRequestInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// OOSAccesses returns a OOSAccessInformer.
+func (v *version) OOSAccesses() OOSAccessInformer {
+	return &oOSAccessInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// VpnConnections returns a VpnConnectionInformer.
+func (v *version) VpnConnections() VpnConnectionInformer {
+	return &vpnConnectionInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,105 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	context "context"
+	time "time"
+
+	apisoksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	clientset "github.com/outscale/goutils/oks/clientset"
+	internalinterfaces "github.com/outscale/goutils/oks/informers/externalversions/internalinterfaces"
+	oksdevv1beta "github.com/outscale/goutils/oks/listers/oks.dev/v1beta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetPeeringAcceptanceInformer provides access to a shared informer and lister for
+// NetPeeringAcceptances.
+type NetPeeringAcceptanceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() oksdevv1beta.NetPeeringAcceptanceLister
+}
+
+type netPeeringAcceptanceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewNetPeeringAcceptanceInformer constructs a new informer for NetPeeringAcceptance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewNetPeeringAcceptanceInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewNetPeeringAcceptanceInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
+}
+
+// NewFilteredNetPeeringAcceptanceInformer constructs a new informer for NetPeeringAcceptance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredNetPeeringAcceptanceInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return NewNetPeeringAcceptanceInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewNetPeeringAcceptanceInformerWithOptions constructs a new informer for NetPeeringAcceptance type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewNetPeeringAcceptanceInformerWithOptions(client clientset.Interface, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "oks.dev", Version: "v1beta", Resource: "netpeeringacceptances"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.OksV1beta().NetPeeringAcceptances().List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.OksV1beta().NetPeeringAcceptances().Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.OksV1beta().NetPeeringAcceptances().List(ctx, opts)
+			},
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.OksV1beta().NetPeeringAcceptances().Watch(ctx, opts)
+			},
+		}, client),
+		&apisoksdevv1beta.NetPeeringAcceptance{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
+		},
+	)
+}
+
+func (f *netPeeringAcceptanceInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewNetPeeringAcceptanceInformerWithOptions(client, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
+}
+
+func (f *netPeeringAcceptanceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisoksdevv1beta.NetPeeringAcceptance{}, f.defaultInformer)
+}
+
+func (f *netPeeringAcceptanceInformer) Lister() oksdevv1beta.NetPeeringAcceptanceLister {
+	return oksdevv1beta.NewNetPeeringAcceptanceLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,60 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package oks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	"github.com/outscale/goutils/oks/apis/oks.dev/v1beta2"
+	"github.com/outscale/goutils/oks/clientset/fake"
+	"github.com/outscale/goutils/oks/informers/externalversions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestInformers(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1beta2.NodePool{ObjectMeta: metav1.ObjectMeta{Name: "pool-a"}},
+		&v1beta.IPPool{ObjectMeta: metav1.ObjectMeta{Name: "ips"}},
+	)
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	nodePools := factory.Oks().V1beta2().NodePools().Lister()
+	ipPools := factory.Oks().V1beta().IPPools().Lister()
+	ctx, cancel := context.WithCancel(t.Context())
+	defer factory.Shutdown()
+	defer cancel()
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			t.Fatalf("%v is not synced", typ)
+		}
+	}
+
+	if _, err := ipPools.Get("ips"); err != nil {
+		t.Fatalf("IPPool not listed: %v", err)
+	}
+	_, err := client.OksV1beta2().NodePools().Create(t.Context(), &v1beta2.NodePool{ObjectMeta: metav1.ObjectMeta{Name: "pool-b"}}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		pools, err := nodePools.List(labels.Everything())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pools) == 2 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("created NodePool not listed, got %d NodePools", len(pools))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
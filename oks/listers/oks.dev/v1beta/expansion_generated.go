@@ -0,0 +1,33 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+// IPPoolListerExpansion allows custom methods to be added to
+// IPPoolLister.
+type IPPoolListerExpansion interface{}
+
+// NetPeeringListerExpansion allows custom methods to be added to
+// NetPeeringLister.
+type NetPeeringListerExpansion interface{}
+
+// NetPeeringAcceptanceListerExpansion allows custom methods to be added to
+// NetPeeringAcceptanceLister.
+type NetPeeringAcceptanceListerExpansion interface{}
+
+// NetPeeringRequestListerExpansion allows custom methods to be added to
+// NetPeeringRequestLister.
+type NetPeeringRequestListerExpansion interface{}
+
+// OOSAccessListerExpansion allows custom methods to be added to
+// OOSAccessLister.
+type OOSAccessListerExpansion interface{}
+
+// VpnConnectionListerExpansion allows custom methods to be added to
+// VpnConnectionLister.
+type VpnConnectionListerExpansion interface{}
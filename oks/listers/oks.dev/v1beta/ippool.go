@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPPoolLister helps list IPPools.
+// All objects returned here must be treated as read-only.
+type IPPoolLister interface {
+	// List lists all IPPools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.IPPool, err error)
+	// Get retrieves the IPPool from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.IPPool, error)
+	IPPoolListerExpansion
+}
+
+// iPPoolLister implements the IPPoolLister interface.
+type iPPoolLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.IPPool]
+}
+
+// NewIPPoolLister returns a new IPPoolLister.
+func NewIPPoolLister(indexer cache.Indexer) IPPoolLister {
+	return &iPPoolLister{listers.New[*oksdevv1beta.IPPool](indexer, oksdevv1beta.Resource("ippool"))}
+}
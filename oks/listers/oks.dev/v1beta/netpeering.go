@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetPeeringLister helps list NetPeerings.
+// All objects returned here must be treated as read-only.
+type NetPeeringLister interface {
+	// List lists all NetPeerings in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.NetPeering, err error)
+	// Get retrieves the NetPeering from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.NetPeering, error)
+	NetPeeringListerExpansion
+}
+
+// netPeeringLister implements the NetPeeringLister interface.
+type netPeeringLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.NetPeering]
+}
+
+// NewNetPeeringLister returns a new NetPeeringLister.
+func NewNetPeeringLister(indexer cache.Indexer) NetPeeringLister {
+	return &netPeeringLister{listers.New[*oksdevv1beta.NetPeering](indexer, oksdevv1beta.Resource("netpeering"))}
+}
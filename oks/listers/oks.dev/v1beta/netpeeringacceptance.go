@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetPeeringAcceptanceLister helps list NetPeeringAcceptances.
+// All objects returned here must be treated as read-only.
+type NetPeeringAcceptanceLister interface {
+	// List lists all NetPeeringAcceptances in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.NetPeeringAcceptance, err error)
+	// Get retrieves the NetPeeringAcceptance from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.NetPeeringAcceptance, error)
+	NetPeeringAcceptanceListerExpansion
+}
+
+// netPeeringAcceptanceLister implements the NetPeeringAcceptanceLister interface.
+type netPeeringAcceptanceLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.NetPeeringAcceptance]
+}
+
+// NewNetPeeringAcceptanceLister returns a new NetPeeringAcceptanceLister.
+func NewNetPeeringAcceptanceLister(indexer cache.Indexer) NetPeeringAcceptanceLister {
+	return &netPeeringAcceptanceLister{listers.New[*oksdevv1beta.NetPeeringAcceptance](indexer, oksdevv1beta.Resource("netpeeringacceptance"))}
+}
@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetPeeringRequestLister helps list NetPeeringRequests.
+// All objects returned here must be treated as read-only.
+type NetPeeringRequestLister interface {
+	// List lists all NetPeeringRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.NetPeeringRequest, err error)
+	// Get retrieves the NetPeeringRequest from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.NetPeeringRequest, error)
+	NetPeeringRequestListerExpansion
+}
+
+// netPeeringRequestLister implements the NetPeeringRequestLister interface.
+type netPeeringRequestLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.NetPeeringRequest]
+}
+
+// NewNetPeeringRequestLister returns a new NetPeeringRequestLister.
+func NewNetPeeringRequestLister(indexer cache.Indexer) NetPeeringRequestLister {
+	return &netPeeringRequestLister{listers.New[*oksdevv1beta.NetPeeringRequest](indexer, oksdevv1beta.Resource("netpeeringrequest"))}
+}
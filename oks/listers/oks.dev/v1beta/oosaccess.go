@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// OOSAccessLister helps list OOSAccesses.
+// All objects returned here must be treated as read-only.
+type OOSAccessLister interface {
+	// List lists all OOSAccesses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.OOSAccess, err error)
+	// Get retrieves the OOSAccess from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.OOSAccess, error)
+	OOSAccessListerExpansion
+}
+
+// oOSAccessLister implements the OOSAccessLister interface.
+type oOSAccessLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.OOSAccess]
+}
+
+// NewOOSAccessLister returns a new OOSAccessLister.
+func NewOOSAccessLister(indexer cache.Indexer) OOSAccessLister {
+	return &oOSAccessLister{listers.New[*oksdevv1beta.OOSAccess](indexer, oksdevv1beta.Resource("oosaccess"))}
+}
@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta
+
+import (
+	oksdevv1beta "github.com/outscale/goutils/oks/apis/oks.dev/v1beta"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VpnConnectionLister helps list VpnConnections.
+// All objects returned here must be treated as read-only.
+type VpnConnectionLister interface {
+	// List lists all VpnConnections in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta.VpnConnection, err error)
+	// Get retrieves the VpnConnection from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta.VpnConnection, error)
+	VpnConnectionListerExpansion
+}
+
+// vpnConnectionLister implements the VpnConnectionLister interface.
+type vpnConnectionLister struct {
+	listers.ResourceIndexer[*oksdevv1beta.VpnConnection]
+}
+
+// NewVpnConnectionLister returns a new VpnConnectionLister.
+func NewVpnConnectionLister(indexer cache.Indexer) VpnConnectionLister {
+	return &vpnConnectionLister{listers.New[*oksdevv1beta.VpnConnection](indexer, oksdevv1beta.Resource("vpnconnection"))}
+}
@@ -0,0 +1,13 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+// NodePoolListerExpansion allows custom methods to be added to
+// NodePoolLister.
+type NodePoolListerExpansion interface{}
@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: 2026 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	oksdevv1beta2 "github.com/outscale/goutils/oks/apis/oks.dev/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NodePoolLister helps list NodePools.
+// All objects returned here must be treated as read-only.
+type NodePoolLister interface {
+	// List lists all NodePools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*oksdevv1beta2.NodePool, err error)
+	// Get retrieves the NodePool from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*oksdevv1beta2.NodePool, error)
+	NodePoolListerExpansion
+}
+
+// nodePoolLister implements the NodePoolLister interface.
+type nodePoolLister struct {
+	listers.ResourceIndexer[*oksdevv1beta2.NodePool]
+}
+
+// NewNodePoolLister returns a new NodePoolLister.
+func NewNodePoolLister(indexer cache.Indexer) NodePoolLister {
+	return &nodePoolLister{listers.New[*oksdevv1beta2.NodePool](indexer, oksdevv1beta2.Resource("nodepool"))}
+}
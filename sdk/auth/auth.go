@@ -5,12 +5,13 @@ import (
 	"errors"
 	"fmt"
 
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
 	"github.com/outscale/goutils/sdk/ptr"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
 )
 
 // ErrInvalidCredentials is returned by CheckCredentials if credentials are invalid.
-var ErrInvalidCredentials = errors.New("authentication error (invalid credentials ?)")
+var ErrInvalidCredentials = sdkerrors.New("authentication error (invalid credentials ?)", sdkerrors.ErrUnauthorized)
 
 // CheckCredentials checks if credentials are valid, and returns ErrInvalidCredentials if not.
 func CheckCredentials(ctx context.Context, client osc.ClientInterface) error {
@@ -18,8 +19,9 @@ func CheckCredentials(ctx context.Context, client osc.ClientInterface) error {
 	if err == nil {
 		return nil
 	}
-	if osc.IsAuthError(err) {
-		err = ErrInvalidCredentials
+	err = sdkerrors.Wrap(err)
+	if errors.Is(err, sdkerrors.ErrUnauthorized) {
+		return fmt.Errorf("check credentials: %w: %w", ErrInvalidCredentials, err)
 	}
 	return fmt.Errorf("check credentials: %w", err)
 }
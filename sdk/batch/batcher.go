@@ -7,15 +7,20 @@ package batch
 
 import (
 	"context"
-	"errors"
 	"reflect"
 	"slices"
 	"time"
 
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
 	"github.com/outscale/goutils/sdk/log"
+	"github.com/outscale/goutils/sdk/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var ErrNotFound = errors.New("not found")
+// ErrNotFound is returned when a resource is not found.
+var ErrNotFound = sdkerrors.ErrNotFound
 
 type result[R any] struct {
 	result *R
@@ -37,6 +42,29 @@ type watcher[Q, R any] struct {
 	resp  chan result[R]
 }
 
+type options struct {
+	tracer trace.Tracer
+	logger log.Logger
+}
+
+// Option configures a batcher.
+type Option func(o *options)
+
+// WithTracerProvider sets the tracer provider of batch refresh spans. otel.GetTracerProvider() is used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracer = tracing.Tracer(tp)
+	}
+}
+
+// WithLogger sets the logger of a batcher. By default, the logger of the context is used: the context of Run
+// for refreshes, and the context of callers for waits.
+func WithLogger(l log.Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
 type (
 	resultFn[Q, R any] func(query Q) (*R, bool)
 	batcher[Q, R any]  struct {
@@ -45,6 +73,8 @@ type (
 		merge    func(query Q, queries []Q) ([]Q, bool)
 		in       chan watcher[Q, R]
 		batches  []batch[Q, R]
+		tracer   trace.Tracer
+		logger   log.Logger
 	}
 )
 
@@ -56,16 +86,34 @@ type batch[Q, R any] struct {
 func newBatcher[Q, R any](interval time.Duration,
 	refresh func(ctx context.Context, queries []Q) (resultFn[Q, R], error),
 	merge func(query Q, queries []Q) ([]Q, bool),
+	opts ...Option,
 ) *batcher[Q, R] {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.tracer == nil {
+		o.tracer = tracing.Tracer(nil)
+	}
 	return &batcher[Q, R]{
 		interval: interval,
 		refresh:  refresh,
 		merge:    merge,
 		in:       make(chan watcher[Q, R]),
+		tracer:   o.tracer,
+		logger:   o.logger,
 	}
 }
 
+func (b *batcher[Q, R]) loggerFor(ctx context.Context) log.Logger {
+	if b.logger != nil {
+		return b.logger
+	}
+	return log.FromContext(ctx)
+}
+
 func (b *batcher[Q, R]) Run(ctx context.Context) {
+	logger := b.loggerFor(ctx).V(5)
 	t := time.NewTicker(b.interval)
 	defer t.Stop()
 LOOPBATCHER:
@@ -89,17 +137,17 @@ LOOPBATCHER:
 			needClean := false
 			for i := range b.batches {
 				batch := &b.batches[i]
-				log.Default.Info(ctx, "Watching resources", "count", len(batch.query))
-				result, err := b.refresh(ctx, batch.query)
+				logger.Info(ctx, "Watching resources", "count", len(batch.query))
+				result, err := b.traceRefresh(ctx, batch)
 				if err != nil {
-					log.Default.Error(ctx, err, "unable to check statuses")
+					logger.Error(ctx, err, "unable to check statuses")
 					continue
 				}
 				var left []watcher[Q, R]
 				for _, w := range batch.watchers {
 					res, found := result(w.query)
 					if !found {
-						log.Default.Info(ctx, "Resource is not found", "id", w.query)
+						logger.Info(ctx, "Resource is not found", "id", w.query)
 						b.response(ctx, w, resultError[R](ErrNotFound))
 						close(w.resp)
 						continue
@@ -107,15 +155,15 @@ LOOPBATCHER:
 					ok, err := w.until(res)
 					switch {
 					case ok:
-						log.Default.Info(ctx, "Resource is ok", "id", w.query)
+						logger.Info(ctx, "Resource is ok", "id", w.query)
 						b.response(ctx, w, resultOk(res))
 						close(w.resp)
 					case err != nil:
-						log.Default.Info(ctx, "Resource is in error", "id", w.query)
+						logger.Info(ctx, "Resource is in error", "id", w.query)
 						b.response(ctx, w, resultError[R](err))
 						close(w.resp)
 					default:
-						log.Default.Info(ctx, "Resource is not ready", "id", w.query)
+						logger.Info(ctx, "Resource is not ready", "id", w.query)
 						left = append(left, w)
 					}
 				}
@@ -137,6 +185,28 @@ LOOPBATCHER:
 	}
 }
 
+// traceRefresh refreshes a batch in a span linked to the spans of all waiters, the context of Run being
+// detached from the waiters.
+func (b *batcher[Q, R]) traceRefresh(ctx context.Context, batch *batch[Q, R]) (resultFn[Q, R], error) {
+	links := make([]trace.Link, 0, len(batch.watchers))
+	for _, w := range batch.watchers {
+		if sc := trace.SpanContextFromContext(w.ctx); sc.IsValid() {
+			links = append(links, trace.Link{SpanContext: sc})
+		}
+	}
+	ctx, span := b.tracer.Start(ctx, "batch refresh", trace.WithLinks(links...), trace.WithAttributes(
+		attribute.Int("batch.queries", len(batch.query)),
+		attribute.Int("batch.waiters", len(batch.watchers)),
+	))
+	defer span.End()
+	result, err := b.refresh(ctx, batch.query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
 func (b *batcher[Q, R]) response(ctx context.Context, w watcher[Q, R], res result[R]) {
 	select {
 	case <-ctx.Done():
@@ -154,7 +224,7 @@ type BatcherByID[R any] struct {
 func (b *BatcherByID[R]) WaitUntil(ctx context.Context, id string, until func(r *R) (ok bool, err error)) (r *R, err error) {
 	start := time.Now()
 	defer func() {
-		log.Default.Info(ctx, "End of wait", "success", err == nil, "duration", time.Since(start))
+		b.loggerFor(ctx).V(5).Info(ctx, "End of wait", "success", err == nil, "duration", time.Since(start))
 	}()
 	resp := make(chan result[R], 1)
 	w := watcher[string, R]{ctx: ctx, query: id, until: until, resp: resp}
@@ -180,6 +250,7 @@ func (b *BatcherByID[R]) Read(ctx context.Context, id string) (r *R, err error)
 
 func NewBatcherByID[R any](interval time.Duration,
 	refresh func(ctx context.Context, ids []string) (resultFn[string, R], error),
+	opts ...Option,
 ) *BatcherByID[R] {
 	return &BatcherByID[R]{
 		batcher: newBatcher(interval, refresh,
@@ -189,6 +260,7 @@ func NewBatcherByID[R any](interval time.Duration,
 				}
 				return append(queries, query), true
 			},
+			opts...,
 		),
 	}
 }
@@ -202,7 +274,7 @@ type BatcherSameQuery[Q, R any] struct {
 func (b *BatcherSameQuery[Q, R]) Read(ctx context.Context, query Q) (r *R, err error) {
 	start := time.Now()
 	defer func() {
-		log.Default.Info(ctx, "End of wait", "success", err == nil, "duration", time.Since(start))
+		b.loggerFor(ctx).V(5).Info(ctx, "End of wait", "success", err == nil, "duration", time.Since(start))
 	}()
 	resp := make(chan result[R], 1)
 	w := watcher[Q, R]{ctx: ctx, query: query, until: func(_ *R) (ok bool, err error) { return true, nil }, resp: resp}
@@ -223,6 +295,7 @@ func (b *BatcherSameQuery[Q, R]) Read(ctx context.Context, query Q) (r *R, err e
 
 func NewBatcherSameQuery[Q, R any](interval time.Duration,
 	refresh func(ctx context.Context, queries []Q) (resultFn[Q, R], error),
+	opts ...Option,
 ) *BatcherSameQuery[Q, R] {
 	return &BatcherSameQuery[Q, R]{
 		batcher: newBatcher(interval, refresh, func(query Q, queries []Q) ([]Q, bool) { // merge
@@ -233,6 +306,6 @@ func NewBatcherSameQuery[Q, R any](interval time.Duration,
 				return append(queries, query), true
 			}
 			return nil, false
-		}),
+		}, opts...),
 	}
 }
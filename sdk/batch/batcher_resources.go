@@ -14,7 +14,7 @@ import (
 	osc "github.com/outscale/osc-sdk-go/v3/pkg/osc"
 )
 
-func NewSecurityGroupBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.SecurityGroup] {
+func NewSecurityGroupBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.SecurityGroup] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.SecurityGroup], error) {
 		req := osc.ReadSecurityGroupsRequest{
 			Filters: &osc.FiltersSecurityGroup{
@@ -35,10 +35,10 @@ func NewSecurityGroupBatcherByID(interval time.Duration, client osc.ClientInterf
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewSecurityGroupBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadSecurityGroupsRequest, osc.ReadSecurityGroupsResponse] {
+func NewSecurityGroupBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadSecurityGroupsRequest, osc.ReadSecurityGroupsResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadSecurityGroupsRequest) (resultFn[osc.ReadSecurityGroupsRequest, osc.ReadSecurityGroupsResponse], error) {
 		resp, err := client.ReadSecurityGroups(ctx, queries[0])
 		if err != nil {
@@ -47,10 +47,10 @@ func NewSecurityGroupBatcherSameQuery(interval time.Duration, client osc.ClientI
 		return func(_ osc.ReadSecurityGroupsRequest) (*osc.ReadSecurityGroupsResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewSubnetBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Subnet] {
+func NewSubnetBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Subnet] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.Subnet], error) {
 		req := osc.ReadSubnetsRequest{
 			Filters: &osc.FiltersSubnet{
@@ -71,10 +71,10 @@ func NewSubnetBatcherByID(interval time.Duration, client osc.ClientInterface) *B
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewSubnetBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadSubnetsRequest, osc.ReadSubnetsResponse] {
+func NewSubnetBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadSubnetsRequest, osc.ReadSubnetsResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadSubnetsRequest) (resultFn[osc.ReadSubnetsRequest, osc.ReadSubnetsResponse], error) {
 		resp, err := client.ReadSubnets(ctx, queries[0])
 		if err != nil {
@@ -83,10 +83,10 @@ func NewSubnetBatcherSameQuery(interval time.Duration, client osc.ClientInterfac
 		return func(_ osc.ReadSubnetsRequest) (*osc.ReadSubnetsResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewNetBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Net] {
+func NewNetBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Net] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.Net], error) {
 		req := osc.ReadNetsRequest{
 			Filters: &osc.FiltersNet{
@@ -107,10 +107,10 @@ func NewNetBatcherByID(interval time.Duration, client osc.ClientInterface) *Batc
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewNetBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadNetsRequest, osc.ReadNetsResponse] {
+func NewNetBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadNetsRequest, osc.ReadNetsResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadNetsRequest) (resultFn[osc.ReadNetsRequest, osc.ReadNetsResponse], error) {
 		resp, err := client.ReadNets(ctx, queries[0])
 		if err != nil {
@@ -119,10 +119,10 @@ func NewNetBatcherSameQuery(interval time.Duration, client osc.ClientInterface)
 		return func(_ osc.ReadNetsRequest) (*osc.ReadNetsResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewSnapshotBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Snapshot] {
+func NewSnapshotBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Snapshot] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.Snapshot], error) {
 		req := osc.ReadSnapshotsRequest{
 			Filters: &osc.FiltersSnapshot{
@@ -143,10 +143,10 @@ func NewSnapshotBatcherByID(interval time.Duration, client osc.ClientInterface)
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewSnapshotBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadSnapshotsRequest, osc.ReadSnapshotsResponse] {
+func NewSnapshotBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadSnapshotsRequest, osc.ReadSnapshotsResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadSnapshotsRequest) (resultFn[osc.ReadSnapshotsRequest, osc.ReadSnapshotsResponse], error) {
 		resp, err := client.ReadSnapshots(ctx, queries[0])
 		if err != nil {
@@ -155,10 +155,10 @@ func NewSnapshotBatcherSameQuery(interval time.Duration, client osc.ClientInterf
 		return func(_ osc.ReadSnapshotsRequest) (*osc.ReadSnapshotsResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewVolumeBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Volume] {
+func NewVolumeBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Volume] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.Volume], error) {
 		req := osc.ReadVolumesRequest{
 			Filters: &osc.FiltersVolume{
@@ -179,10 +179,10 @@ func NewVolumeBatcherByID(interval time.Duration, client osc.ClientInterface) *B
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewVolumeBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadVolumesRequest, osc.ReadVolumesResponse] {
+func NewVolumeBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadVolumesRequest, osc.ReadVolumesResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadVolumesRequest) (resultFn[osc.ReadVolumesRequest, osc.ReadVolumesResponse], error) {
 		resp, err := client.ReadVolumes(ctx, queries[0])
 		if err != nil {
@@ -191,10 +191,10 @@ func NewVolumeBatcherSameQuery(interval time.Duration, client osc.ClientInterfac
 		return func(_ osc.ReadVolumesRequest) (*osc.ReadVolumesResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewVmBatcherByID(interval time.Duration, client osc.ClientInterface) *BatcherByID[osc.Vm] {
+func NewVmBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.Vm] {
 	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.Vm], error) {
 		req := osc.ReadVmsRequest{
 			Filters: &osc.FiltersVm{
@@ -215,10 +215,10 @@ func NewVmBatcherByID(interval time.Duration, client osc.ClientInterface) *Batch
 			}
 			return nil, false
 		}, nil
-	})
+	}, opts...)
 }
 
-func NewVmBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *BatcherSameQuery[osc.ReadVmsRequest, osc.ReadVmsResponse] {
+func NewVmBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadVmsRequest, osc.ReadVmsResponse] {
 	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadVmsRequest) (resultFn[osc.ReadVmsRequest, osc.ReadVmsResponse], error) {
 		resp, err := client.ReadVms(ctx, queries[0])
 		if err != nil {
@@ -227,5 +227,41 @@ func NewVmBatcherSameQuery(interval time.Duration, client osc.ClientInterface) *
 		return func(_ osc.ReadVmsRequest) (*osc.ReadVmsResponse, bool) {
 			return resp, true
 		}, nil
-	})
+	}, opts...)
+}
+
+func NewPublicIpBatcherByID(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherByID[osc.PublicIp] {
+	return NewBatcherByID(interval, func(ctx context.Context, ids []string) (resultFn[string, osc.PublicIp], error) {
+		req := osc.ReadPublicIpsRequest{
+			Filters: &osc.FiltersPublicIp{
+				PublicIpIds: &ids,
+			},
+			ResultsPerPage: ptr.To(len(ids)),
+		}
+		resp, err := client.ReadPublicIps(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("read public ips: %w", err)
+		}
+		res := *resp.PublicIps
+		return func(query string) (*osc.PublicIp, bool) {
+			for i := range res {
+				if res[i].PublicIpId == query {
+					return &res[i], true
+				}
+			}
+			return nil, false
+		}, nil
+	}, opts...)
+}
+
+func NewPublicIpBatcherSameQuery(interval time.Duration, client osc.ClientInterface, opts ...Option) *BatcherSameQuery[osc.ReadPublicIpsRequest, osc.ReadPublicIpsResponse] {
+	return NewBatcherSameQuery(interval, func(ctx context.Context, queries []osc.ReadPublicIpsRequest) (resultFn[osc.ReadPublicIpsRequest, osc.ReadPublicIpsResponse], error) {
+		resp, err := client.ReadPublicIps(ctx, queries[0])
+		if err != nil {
+			return nil, fmt.Errorf("read public ips: %w", err)
+		}
+		return func(_ osc.ReadPublicIpsRequest) (*osc.ReadPublicIpsResponse, bool) {
+			return resp, true
+		}, nil
+	}, opts...)
 }
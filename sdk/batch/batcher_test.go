@@ -14,10 +14,17 @@ import (
 	"time"
 
 	"github.com/outscale/goutils/sdk/batch"
+	"github.com/outscale/goutils/sdk/chaos"
+	"github.com/outscale/goutils/sdk/fake_osc"
 	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
 	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 )
 
@@ -25,9 +32,9 @@ func TestBatcherById_Volumes(t *testing.T) {
 	t.Run("When concurrent calls are made, the right status is returned to the right volume", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadVolumes(gomock.Any(), gomock.Cond(func(req osc.ReadVolumesRequest) bool {
-			return len(*req.Filters.VolumeIds) == 4
-		})).Return(&osc.ReadVolumesResponse{Volumes: &[]osc.Volume{
+		mockSDK.EXPECT().ReadVolumes(gomock.Any(), mocks_osc.Eq(osc.ReadVolumesRequest{
+			Filters: &osc.FiltersVolume{VolumeIds: &[]string{"id-creating", "id-available", "id-in-use", "id-error"}},
+		}, mocks_osc.Fields("Filters.VolumeIds"))).Return(&osc.ReadVolumesResponse{Volumes: &[]osc.Volume{
 			{VolumeId: "id-creating", State: osc.VolumeStateCreating},
 			{VolumeId: "id-available", State: osc.VolumeStateAvailable},
 			{VolumeId: "id-in-use", State: osc.VolumeStateInUse},
@@ -129,9 +136,9 @@ func TestBatcherById_Snapshots(t *testing.T) {
 	t.Run("When concurrent calls are made, the right status is returned to the right snapshot", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadSnapshots(gomock.Any(), gomock.Cond(func(req osc.ReadSnapshotsRequest) bool {
-			return len(*req.Filters.SnapshotIds) == 4
-		})).Return(&osc.ReadSnapshotsResponse{Snapshots: &[]osc.Snapshot{
+		mockSDK.EXPECT().ReadSnapshots(gomock.Any(), mocks_osc.Eq(osc.ReadSnapshotsRequest{
+			Filters: &osc.FiltersSnapshot{SnapshotIds: &[]string{"id-completed", "id-pending", "id-deleting", "id-error"}},
+		}, mocks_osc.Fields("Filters.SnapshotIds"))).Return(&osc.ReadSnapshotsResponse{Snapshots: &[]osc.Snapshot{
 			{SnapshotId: "id-completed", State: osc.SnapshotStateCompleted},
 			{SnapshotId: "id-pending", State: osc.SnapshotStatePending},
 			{SnapshotId: "id-deleting", State: osc.SnapshotStateDeleting},
@@ -233,9 +240,9 @@ func TestBatcherById_Vms(t *testing.T) {
 	t.Run("When concurrent calls are made, the right status is returned to the right Vm", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadVms(gomock.Any(), gomock.Cond(func(req osc.ReadVmsRequest) bool {
-			return len(*req.Filters.VmIds) == 4
-		})).Return(&osc.ReadVmsResponse{Vms: &[]osc.Vm{
+		mockSDK.EXPECT().ReadVms(gomock.Any(), mocks_osc.Eq(osc.ReadVmsRequest{
+			Filters: &osc.FiltersVm{VmIds: &[]string{"id-pending", "id-running", "id-stopped", "id-terminated"}},
+		}, mocks_osc.Fields("Filters.VmIds"))).Return(&osc.ReadVmsResponse{Vms: &[]osc.Vm{
 			{VmId: "id-pending", State: osc.VmStatePending},
 			{VmId: "id-running", State: osc.VmStateRunning},
 			{VmId: "id-stopped", State: osc.VmStateStopped},
@@ -337,9 +344,9 @@ func TestBatcherById_SGs(t *testing.T) {
 	t.Run("When concurrent calls are made, the right name is returned to the right Security Group", func(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockSDK := mocks_osc.NewMockClient(mockCtrl)
-		mockSDK.EXPECT().ReadSecurityGroups(gomock.Any(), gomock.Cond(func(req osc.ReadSecurityGroupsRequest) bool {
-			return len(*req.Filters.SecurityGroupIds) == 4
-		})).Return(&osc.ReadSecurityGroupsResponse{SecurityGroups: &[]osc.SecurityGroup{
+		mockSDK.EXPECT().ReadSecurityGroups(gomock.Any(), mocks_osc.Eq(osc.ReadSecurityGroupsRequest{
+			Filters: &osc.FiltersSecurityGroup{SecurityGroupIds: &[]string{"id-one", "id-two", "id-three", "id-four"}},
+		}, mocks_osc.Fields("Filters.SecurityGroupIds"))).Return(&osc.ReadSecurityGroupsResponse{SecurityGroups: &[]osc.SecurityGroup{
 			{SecurityGroupId: "id-one", SecurityGroupName: "one"},
 			{SecurityGroupId: "id-two", SecurityGroupName: "two"},
 			{SecurityGroupId: "id-three", SecurityGroupName: "three"},
@@ -642,3 +649,93 @@ func TestBatcherSameQuery_Subnets(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestBatcher_Tracing(t *testing.T) {
+	t.Run("Refresh spans are linked to the spans of waiters", func(t *testing.T) {
+		exp := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadVolumes(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ osc.ReadVolumesRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadVolumesResponse, error) {
+				assert.True(t, trace.SpanContextFromContext(ctx).IsValid(), "refresh is called within a span")
+				return &osc.ReadVolumesResponse{Volumes: &[]osc.Volume{{VolumeId: "id-foo"}, {VolumeId: "id-bar"}}}, nil
+			})
+
+		rw := batch.NewVolumeBatcherByID(100*time.Millisecond, mockSDK, batch.WithTracerProvider(tp))
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+		defer cancel()
+		go rw.Run(ctx)
+
+		var wg sync.WaitGroup
+		parents := map[string]trace.SpanContext{}
+		for _, id := range []string{"id-foo", "id-bar"} {
+			ctx, span := tp.Tracer("test").Start(ctx, "reconcile "+id)
+			parents[id] = span.SpanContext()
+			wg.Go(func() {
+				defer span.End()
+				_, err := rw.Read(ctx, id)
+				assert.NoError(t, err)
+			})
+		}
+		wg.Wait()
+
+		var refresh sdktrace.ReadOnlySpan
+		for _, span := range exp.GetSpans().Snapshots() {
+			if span.Name() == "batch refresh" {
+				refresh = span
+			}
+		}
+		require.NotNil(t, refresh)
+		var linked []trace.SpanContext
+		for _, l := range refresh.Links() {
+			linked = append(linked, l.SpanContext)
+		}
+		assert.ElementsMatch(t, []trace.SpanContext{parents["id-foo"], parents["id-bar"]}, linked)
+	})
+}
+
+func TestBatcherById_EventualConsistency(t *testing.T) {
+	create := func(t *testing.T, c osc.ClientInterface) string {
+		res, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{Size: ptr.To(10), SubregionName: "eu-west-2a"})
+		require.NoError(t, err)
+		return res.Volume.VolumeId
+	}
+	available := func(v *osc.Volume) (bool, error) {
+		return v.State == osc.VolumeStateAvailable, nil
+	}
+	t.Run("ErrNotFound is returned if a volume is not yet visible after its creation", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.SetConsistency("CreateVolume", chaos.Consistency{Reads: 1})
+		id := create(t, c)
+
+		rw := batch.NewVolumeBatcherByID(10*time.Millisecond, c)
+		go rw.Run(t.Context())
+		_, err := rw.WaitUntil(t.Context(), id, available)
+		require.ErrorIs(t, err, batch.ErrNotFound)
+		v, err := rw.WaitUntil(t.Context(), id, available)
+		require.NoError(t, err, "the volume is found once consistent")
+		assert.Equal(t, id, v.VolumeId)
+	})
+	t.Run("Stale volumes are waited for", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{TransientReads: 1}), 1)
+		c.SetConsistency("CreateVolume", chaos.Consistency{Reads: 3, Stale: true})
+		id := create(t, c)
+
+		rw := batch.NewVolumeBatcherByID(10*time.Millisecond, c)
+		go rw.Run(t.Context())
+		v, err := rw.WaitUntil(t.Context(), id, available)
+		require.NoError(t, err)
+		assert.Equal(t, osc.VolumeStateAvailable, v.State)
+	})
+	t.Run("Throttled refreshes are retried at the next tick", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		id := create(t, c)
+		c.Throttle("ReadVolumes", 2)
+
+		rw := batch.NewVolumeBatcherByID(10*time.Millisecond, c)
+		go rw.Run(t.Context())
+		_, err := rw.WaitUntil(t.Context(), id, available)
+		require.NoError(t, err)
+	})
+}
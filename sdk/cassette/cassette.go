@@ -0,0 +1,186 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package cassette records OAPI and OKS calls to cassette files, and replays them without network access.
+//
+// A cassette recorded during a manual session against a real account may be replayed by tests:
+//
+//	c := cassette.New()
+//	client, err := osc.NewClient(prof, cassette.Record(c, ua))
+//	// ... calls ...
+//	err = c.Save("testdata/foo.json")
+//
+//	c, err := cassette.Load("testdata/foo.json")
+//	client, err := osc.NewClient(prof, cassette.Replay(c, ua))
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+
+	sdklog "github.com/outscale/goutils/sdk/log"
+)
+
+// Version is the version of the cassette file format.
+const Version = 1
+
+var (
+	// ErrUnsupportedVersion is returned when loading a cassette having an unknown version.
+	ErrUnsupportedVersion = errors.New("unsupported cassette version")
+	// ErrNoInteraction is returned when replaying a call not found in a cassette.
+	ErrNoInteraction = errors.New("no matching interaction in cassette")
+)
+
+// DefaultRedactor is the default deny-list of secret fields, scrubbed from recorded bodies.
+var DefaultRedactor = sdklog.DefaultRedactor.With("kubeconfig")
+
+// Cassette is a list of recorded interactions.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+
+	// Redactor scrubs secrets from recorded bodies. Defaults to DefaultRedactor.
+	Redactor *sdklog.Redactor `json:"-"`
+
+	mu     sync.Mutex
+	played []bool
+}
+
+// Interaction is a recorded call.
+type Interaction struct {
+	// Call is the OAPI call name (e.g. ReadVms), or the method and path of REST calls (e.g. GET /api/v2/clusters).
+	Call     string   `json:"call"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// New returns an empty cassette.
+func New() *Cassette {
+	return &Cassette{Version: Version}
+}
+
+// Load loads a cassette from a file.
+func Load(path string) (*Cassette, error) {
+	buf, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("load cassette: %w", err)
+	}
+	c := &Cassette{}
+	if err := json.Unmarshal(buf, c); err != nil {
+		return nil, fmt.Errorf("load cassette %s: %w", path, err)
+	}
+	if c.Version != Version {
+		return nil, fmt.Errorf("load cassette %s: %w %d", path, ErrUnsupportedVersion, c.Version)
+	}
+	return c, nil
+}
+
+// Save saves a cassette to a file.
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	buf, err := json.MarshalIndent(c, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("save cassette: %w", err)
+	}
+	if err := os.WriteFile(path, append(buf, '\n'), 0o600); err != nil {
+		return fmt.Errorf("save cassette: %w", err)
+	}
+	return nil
+}
+
+// Unplayed returns the interactions that have not been replayed.
+func (c *Cassette) Unplayed() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var res []Interaction
+	for i, in := range c.Interactions {
+		if i >= len(c.played) || !c.played[i] {
+			res = append(res, in)
+		}
+	}
+	return res
+}
+
+func (c *Cassette) redactor() *sdklog.Redactor {
+	if c.Redactor == nil {
+		return DefaultRedactor
+	}
+	return c.Redactor
+}
+
+func (c *Cassette) record(in Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, in)
+}
+
+// play returns the first interaction not yet replayed, matching a call and a canonical request body.
+func (c *Cassette) play(call string, body []byte) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.played) < len(c.Interactions) {
+		c.played = append(c.played, make([]bool, len(c.Interactions)-len(c.played))...)
+	}
+	body = canonical(body)
+	for i, in := range c.Interactions {
+		if c.played[i] || in.Call != call || !bytes.Equal(canonical([]byte(in.Request.Body)), body) {
+			continue
+		}
+		c.played[i] = true
+		return in, true
+	}
+	return Interaction{}, false
+}
+
+// canonical returns the canonical form of a JSON body, with sorted keys and without spaces.
+// Bodies that are not JSON are returned unchanged.
+func canonical(body []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return buf
+}
+
+// headers returns the headers kept in cassettes.
+func headers(h http.Header, keep ...string) http.Header {
+	res := http.Header{}
+	for _, k := range keep {
+		if v := h.Values(k); len(v) > 0 {
+			res[http.CanonicalHeaderKey(k)] = slices.Clone(v)
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
@@ -0,0 +1,129 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package cassette_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/outscale/goutils/sdk/cassette"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	sdklog "github.com/outscale/goutils/sdk/log"
+	"github.com/outscale/goutils/sdk/oapitest"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/oks"
+	"github.com/outscale/osc-sdk-go/v3/pkg/options"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/outscale/osc-sdk-go/v3/pkg/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func session(t *testing.T, prof *profile.Profile, opt middleware.MiddlewareChainOption) []osc.VmState {
+	client, err := osc.NewClient(prof, options.WithoutRetry(), opt)
+	require.NoError(t, err)
+	res, err := client.CreateVms(t.Context(), osc.CreateVmsRequest{ImageId: "ami-foo", UserData: ptr.To("secret")})
+	require.NoError(t, err)
+	states := []osc.VmState{(*res.Vms)[0].State}
+	for range 2 {
+		res, err := client.ReadVms(t.Context(), osc.ReadVmsRequest{Filters: &osc.FiltersVm{VmIds: &[]string{(*res.Vms)[0].VmId}}})
+		require.NoError(t, err)
+		states = append(states, (*res.Vms)[0].State)
+	}
+	return states
+}
+
+func TestCassette_OAPI(t *testing.T) {
+	srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{TransientReads: 1})))
+	prof := srv.Profile()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	c := cassette.New()
+	recorded := session(t, prof, cassette.Record(c, "goutils-test"))
+	require.NoError(t, c.Save(path))
+	srv.Close()
+	assert.Equal(t, []osc.VmState{osc.VmStatePending, osc.VmStatePending, osc.VmStateRunning}, recorded)
+
+	t.Run("Secrets are scrubbed, and signatures normalized", func(t *testing.T) {
+		buf, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(buf), oapitest.AccessKey)
+		assert.NotContains(t, string(buf), "secret")
+		c, err := cassette.Load(path)
+		require.NoError(t, err)
+		require.Len(t, c.Interactions, 3)
+		in := c.Interactions[0]
+		assert.Equal(t, "CreateVms", in.Call)
+		assert.Equal(t, "AWS4-HMAC-SHA256 "+sdklog.Redacted, in.Request.Header.Get("Authorization"))
+		assert.Equal(t, "19700101T000000Z", in.Request.Header.Get("X-Amz-Date"))
+		assert.Equal(t, "goutils-test", in.Request.Header.Get("User-Agent"))
+		assert.Equal(t, http.StatusOK, in.Response.Status)
+	})
+	t.Run("Calls are replayed in order, without network", func(t *testing.T) {
+		c, err := cassette.Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, recorded, session(t, prof, cassette.Replay(c, "goutils-test")))
+		assert.Empty(t, c.Unplayed())
+	})
+	t.Run("Calls are matched by call name and canonical body", func(t *testing.T) {
+		c, err := cassette.Load(path)
+		require.NoError(t, err)
+		client, err := osc.NewClient(prof, options.WithoutRetry(), cassette.Replay(c, ""))
+		require.NoError(t, err)
+		_, err = client.ReadVms(t.Context(), osc.ReadVmsRequest{})
+		require.ErrorIs(t, err, cassette.ErrNoInteraction)
+		_, err = client.CreateVms(t.Context(), osc.CreateVmsRequest{ImageId: "ami-foo", UserData: ptr.To("other secret")})
+		require.NoError(t, err, "redacted fields are ignored")
+		assert.Len(t, c.Unplayed(), 2)
+	})
+	t.Run("Unknown versions are rejected", func(t *testing.T) {
+		buf, err := json.Marshal(map[string]any{"version": cassette.Version + 1})
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		require.NoError(t, os.WriteFile(path, buf, 0o600))
+		_, err = cassette.Load(path)
+		assert.ErrorIs(t, err, cassette.ErrUnsupportedVersion)
+	})
+}
+
+func TestCassette_OKS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v2/clusters/foo/kubeconfig" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(oks.KubeconfigResponse{Cluster: oks.ClustersClusterSchemaRPCResponse{
+			Data: oks.KubeconfigData{Kubeconfig: "apiVersion: v1"},
+		}})
+	}))
+	defer srv.Close()
+	prof := &profile.Profile{
+		AccessKey: oapitest.AccessKey,
+		SecretKey: oapitest.SecretKey,
+		Region:    oapitest.Region,
+		Endpoints: profile.Endpoint{OKS: srv.URL + "/api/v2"},
+	}
+	c := cassette.New()
+	client, err := oks.NewClient(prof, options.WithoutRetry(), cassette.Record(c, ""))
+	require.NoError(t, err)
+	_, err = client.GetKubeconfig(t.Context(), "foo", &oks.GetKubeconfigParams{User: ptr.To("bar")})
+	require.NoError(t, err)
+	require.Len(t, c.Interactions, 1)
+	assert.Equal(t, "GET /api/v2/clusters/foo/kubeconfig?user=bar", c.Interactions[0].Call)
+	assert.NotContains(t, c.Interactions[0].Response.Body, "apiVersion")
+
+	client, err = oks.NewClient(prof, options.WithoutRetry(), cassette.Replay(c, ""))
+	require.NoError(t, err)
+	res, err := client.GetKubeconfig(t.Context(), "foo", &oks.GetKubeconfigParams{User: ptr.To("bar")})
+	require.NoError(t, err)
+	assert.Equal(t, sdklog.Redacted, res.Cluster.Data.Kubeconfig)
+}
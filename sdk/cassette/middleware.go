@@ -0,0 +1,182 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	sdklog "github.com/outscale/goutils/sdk/log"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware/useragent"
+)
+
+// Mode is the mode of a cassette middleware.
+type Mode int
+
+const (
+	// ModeRecord sends requests and records interactions.
+	ModeRecord Mode = iota
+	// ModeReplay replays interactions, without sending requests.
+	ModeReplay
+)
+
+// epoch replaces signature dates in recorded requests.
+const epoch = "19700101T000000Z"
+
+// Record records calls to a cassette, which needs to be saved once calls are done.
+// It replaces the user agent middleware, which is wrapped, and needs to be set after any user agent option.
+func Record(c *Cassette, ua string) middleware.MiddlewareChainOption {
+	return middleware.WithMiddleware(middleware.MiddlewareSlotUseragent, &Middleware{Cassette: c, Mode: ModeRecord, Next: userAgent(ua)})
+}
+
+// Replay replays calls from a cassette.
+// It replaces the user agent middleware, which is wrapped, and needs to be set after any user agent option.
+func Replay(c *Cassette, ua string) middleware.MiddlewareChainOption {
+	return middleware.WithMiddleware(middleware.MiddlewareSlotUseragent, &Middleware{Cassette: c, Mode: ModeReplay, Next: userAgent(ua)})
+}
+
+func userAgent(ua string) middleware.Middleware {
+	if ua == "" {
+		return nil
+	}
+	return &useragent.UseragentMiddleware{Useragent: ua}
+}
+
+// Middleware records or replays calls. Being the innermost middleware, it sees signed requests and each retry.
+type Middleware struct {
+	Cassette *Cassette
+	Mode     Mode
+	// Next is the wrapped middleware, usually the user agent middleware.
+	Next middleware.Middleware
+}
+
+func (m *Middleware) Decorate(next http.RoundTripper) http.RoundTripper {
+	var rt http.RoundTripper
+	switch m.Mode {
+	case ModeReplay:
+		rt = &replayer{cassette: m.Cassette}
+	default:
+		rt = &recorder{inner: next, cassette: m.Cassette}
+	}
+	if m.Next != nil {
+		rt = m.Next.Decorate(rt)
+	}
+	return rt
+}
+
+type recorder struct {
+	inner    http.RoundTripper
+	cassette *Cassette
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	rbody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(rbody))
+
+	call := callName(req)
+	redactor := r.cassette.redactor()
+	r.cassette.record(Interaction{
+		Call: call,
+		Request: Request{
+			Header: normalize(req.Header),
+			Body:   string(redactor.Redact(call, body)),
+		},
+		Response: Response{
+			Status: resp.StatusCode,
+			Header: headers(resp.Header, "Content-Type"),
+			Body:   string(redactor.Redact(call, rbody)),
+		},
+	})
+	return resp, nil
+}
+
+type replayer struct {
+	cassette *Cassette
+}
+
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	call := callName(req)
+	in, found := r.cassette.play(call, r.cassette.redactor().Redact(call, body))
+	if !found {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, call, body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+		StatusCode:    in.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        in.Response.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+		ContentLength: int64(len(in.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// requestBody reads the body of a request, leaving it readable.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read request: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// callName returns the OAPI call name of a request (e.g. ReadVms), or its method and path for REST calls,
+// e.g. OKS calls (e.g. GET /api/v2/clusters?name=foo).
+func callName(req *http.Request) string {
+	if dir, call := path.Split(req.URL.Path); req.Method == http.MethodPost && strings.HasSuffix(dir, "/api/v1/") {
+		return call
+	}
+	name := req.Method + " " + req.URL.Path
+	if q := req.URL.Query(); len(q) > 0 {
+		name += "?" + q.Encode()
+	}
+	return name
+}
+
+// normalize returns the request headers kept in cassettes, with credentials and signatures redacted, and
+// signature dates replaced, for cassettes to be stable across recordings.
+func normalize(h http.Header) http.Header {
+	res := headers(h, "Content-Type", "User-Agent")
+	if auth := h.Get("Authorization"); auth != "" {
+		if res == nil {
+			res = http.Header{}
+		}
+		scheme, _, _ := strings.Cut(auth, " ")
+		res.Set("Authorization", scheme+" "+sdklog.Redacted)
+		if h.Get("X-Amz-Date") != "" {
+			res.Set("X-Amz-Date", epoch)
+		}
+	}
+	return res
+}
@@ -0,0 +1,3787 @@
+// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package chaos
+
+import (
+	"context"
+	"io"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+func (c *Client) AcceptNetPeering(ctx context.Context, req osc.AcceptNetPeeringRequest, opts ...middleware.MiddlewareChainOption) (*osc.AcceptNetPeeringResponse, error) {
+	if err := c.inject(ctx, "AcceptNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.AcceptNetPeering(ctx, req, opts...)
+	return observe(c, "AcceptNetPeering", res, err)
+}
+
+func (c *Client) AcceptNetPeeringWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.AcceptNetPeeringResponse, error) {
+	if err := c.inject(ctx, "AcceptNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.AcceptNetPeeringWithBody(ctx, contentType, body, opts...)
+	return observe(c, "AcceptNetPeering", res, err)
+}
+
+func (c *Client) AddUserToUserGroup(ctx context.Context, req osc.AddUserToUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.AddUserToUserGroupResponse, error) {
+	if err := c.inject(ctx, "AddUserToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.AddUserToUserGroup(ctx, req, opts...)
+	return observe(c, "AddUserToUserGroup", res, err)
+}
+
+func (c *Client) AddUserToUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.AddUserToUserGroupResponse, error) {
+	if err := c.inject(ctx, "AddUserToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.AddUserToUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "AddUserToUserGroup", res, err)
+}
+
+func (c *Client) CheckAuthentication(ctx context.Context, req osc.CheckAuthenticationRequest, opts ...middleware.MiddlewareChainOption) (*osc.CheckAuthenticationResponse, error) {
+	if err := c.inject(ctx, "CheckAuthentication"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CheckAuthentication(ctx, req, opts...)
+	return observe(c, "CheckAuthentication", res, err)
+}
+
+func (c *Client) CheckAuthenticationWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CheckAuthenticationResponse, error) {
+	if err := c.inject(ctx, "CheckAuthentication"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CheckAuthenticationWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CheckAuthentication", res, err)
+}
+
+func (c *Client) CreateAccessKey(ctx context.Context, req osc.CreateAccessKeyRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateAccessKeyResponse, error) {
+	if err := c.inject(ctx, "CreateAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateAccessKey(ctx, req, opts...)
+	return observe(c, "CreateAccessKey", res, err)
+}
+
+func (c *Client) CreateAccessKeyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateAccessKeyResponse, error) {
+	if err := c.inject(ctx, "CreateAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateAccessKeyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateAccessKey", res, err)
+}
+
+func (c *Client) CreateAccount(ctx context.Context, req osc.CreateAccountRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateAccountResponse, error) {
+	if err := c.inject(ctx, "CreateAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateAccount(ctx, req, opts...)
+	return observe(c, "CreateAccount", res, err)
+}
+
+func (c *Client) CreateAccountWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateAccountResponse, error) {
+	if err := c.inject(ctx, "CreateAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateAccountWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateAccount", res, err)
+}
+
+func (c *Client) CreateApiAccessRule(ctx context.Context, req osc.CreateApiAccessRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "CreateApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateApiAccessRule(ctx, req, opts...)
+	return observe(c, "CreateApiAccessRule", res, err)
+}
+
+func (c *Client) CreateApiAccessRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "CreateApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateApiAccessRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateApiAccessRule", res, err)
+}
+
+func (c *Client) CreateCa(ctx context.Context, req osc.CreateCaRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateCaResponse, error) {
+	if err := c.inject(ctx, "CreateCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateCa(ctx, req, opts...)
+	return observe(c, "CreateCa", res, err)
+}
+
+func (c *Client) CreateCaWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateCaResponse, error) {
+	if err := c.inject(ctx, "CreateCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateCaWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateCa", res, err)
+}
+
+func (c *Client) CreateClientGateway(ctx context.Context, req osc.CreateClientGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateClientGatewayResponse, error) {
+	if err := c.inject(ctx, "CreateClientGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateClientGateway(ctx, req, opts...)
+	return observe(c, "CreateClientGateway", res, err)
+}
+
+func (c *Client) CreateClientGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateClientGatewayResponse, error) {
+	if err := c.inject(ctx, "CreateClientGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateClientGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateClientGateway", res, err)
+}
+
+func (c *Client) CreateDedicatedGroup(ctx context.Context, req osc.CreateDedicatedGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "CreateDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDedicatedGroup(ctx, req, opts...)
+	return observe(c, "CreateDedicatedGroup", res, err)
+}
+
+func (c *Client) CreateDedicatedGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "CreateDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDedicatedGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateDedicatedGroup", res, err)
+}
+
+func (c *Client) CreateDhcpOptions(ctx context.Context, req osc.CreateDhcpOptionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "CreateDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDhcpOptions(ctx, req, opts...)
+	return observe(c, "CreateDhcpOptions", res, err)
+}
+
+func (c *Client) CreateDhcpOptionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "CreateDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDhcpOptionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateDhcpOptions", res, err)
+}
+
+func (c *Client) CreateDirectLink(ctx context.Context, req osc.CreateDirectLinkRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateDirectLinkResponse, error) {
+	if err := c.inject(ctx, "CreateDirectLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDirectLink(ctx, req, opts...)
+	return observe(c, "CreateDirectLink", res, err)
+}
+
+func (c *Client) CreateDirectLinkInterface(ctx context.Context, req osc.CreateDirectLinkInterfaceRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "CreateDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDirectLinkInterface(ctx, req, opts...)
+	return observe(c, "CreateDirectLinkInterface", res, err)
+}
+
+func (c *Client) CreateDirectLinkInterfaceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "CreateDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDirectLinkInterfaceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateDirectLinkInterface", res, err)
+}
+
+func (c *Client) CreateDirectLinkWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateDirectLinkResponse, error) {
+	if err := c.inject(ctx, "CreateDirectLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateDirectLinkWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateDirectLink", res, err)
+}
+
+func (c *Client) CreateFlexibleGpu(ctx context.Context, req osc.CreateFlexibleGpuRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "CreateFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateFlexibleGpu(ctx, req, opts...)
+	return observe(c, "CreateFlexibleGpu", res, err)
+}
+
+func (c *Client) CreateFlexibleGpuWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "CreateFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateFlexibleGpuWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateFlexibleGpu", res, err)
+}
+
+func (c *Client) CreateImage(ctx context.Context, req osc.CreateImageRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateImageResponse, error) {
+	if err := c.inject(ctx, "CreateImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateImage(ctx, req, opts...)
+	return observe(c, "CreateImage", res, err)
+}
+
+func (c *Client) CreateImageExportTask(ctx context.Context, req osc.CreateImageExportTaskRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateImageExportTaskResponse, error) {
+	if err := c.inject(ctx, "CreateImageExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateImageExportTask(ctx, req, opts...)
+	return observe(c, "CreateImageExportTask", res, err)
+}
+
+func (c *Client) CreateImageExportTaskWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateImageExportTaskResponse, error) {
+	if err := c.inject(ctx, "CreateImageExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateImageExportTaskWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateImageExportTask", res, err)
+}
+
+func (c *Client) CreateImageWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateImageResponse, error) {
+	if err := c.inject(ctx, "CreateImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateImageWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateImage", res, err)
+}
+
+func (c *Client) CreateInternetService(ctx context.Context, req osc.CreateInternetServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateInternetServiceResponse, error) {
+	if err := c.inject(ctx, "CreateInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateInternetService(ctx, req, opts...)
+	return observe(c, "CreateInternetService", res, err)
+}
+
+func (c *Client) CreateInternetServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateInternetServiceResponse, error) {
+	if err := c.inject(ctx, "CreateInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateInternetServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateInternetService", res, err)
+}
+
+func (c *Client) CreateKeypair(ctx context.Context, req osc.CreateKeypairRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateKeypairResponse, error) {
+	if err := c.inject(ctx, "CreateKeypair"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateKeypair(ctx, req, opts...)
+	return observe(c, "CreateKeypair", res, err)
+}
+
+func (c *Client) CreateKeypairWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateKeypairResponse, error) {
+	if err := c.inject(ctx, "CreateKeypair"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateKeypairWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateKeypair", res, err)
+}
+
+func (c *Client) CreateListenerRule(ctx context.Context, req osc.CreateListenerRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateListenerRuleResponse, error) {
+	if err := c.inject(ctx, "CreateListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateListenerRule(ctx, req, opts...)
+	return observe(c, "CreateListenerRule", res, err)
+}
+
+func (c *Client) CreateListenerRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateListenerRuleResponse, error) {
+	if err := c.inject(ctx, "CreateListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateListenerRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateListenerRule", res, err)
+}
+
+func (c *Client) CreateLoadBalancer(ctx context.Context, req osc.CreateLoadBalancerRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancer(ctx, req, opts...)
+	return observe(c, "CreateLoadBalancer", res, err)
+}
+
+func (c *Client) CreateLoadBalancerListeners(ctx context.Context, req osc.CreateLoadBalancerListenersRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerListenersResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerListeners"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerListeners(ctx, req, opts...)
+	return observe(c, "CreateLoadBalancerListeners", res, err)
+}
+
+func (c *Client) CreateLoadBalancerListenersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerListenersResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerListeners"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerListenersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateLoadBalancerListeners", res, err)
+}
+
+func (c *Client) CreateLoadBalancerPolicy(ctx context.Context, req osc.CreateLoadBalancerPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerPolicyResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerPolicy(ctx, req, opts...)
+	return observe(c, "CreateLoadBalancerPolicy", res, err)
+}
+
+func (c *Client) CreateLoadBalancerPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerPolicyResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateLoadBalancerPolicy", res, err)
+}
+
+func (c *Client) CreateLoadBalancerTags(ctx context.Context, req osc.CreateLoadBalancerTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerTags(ctx, req, opts...)
+	return observe(c, "CreateLoadBalancerTags", res, err)
+}
+
+func (c *Client) CreateLoadBalancerTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateLoadBalancerTags", res, err)
+}
+
+func (c *Client) CreateLoadBalancerWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "CreateLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateLoadBalancerWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateLoadBalancer", res, err)
+}
+
+func (c *Client) CreateNatService(ctx context.Context, req osc.CreateNatServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateNatServiceResponse, error) {
+	if err := c.inject(ctx, "CreateNatService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNatService(ctx, req, opts...)
+	return observe(c, "CreateNatService", res, err)
+}
+
+func (c *Client) CreateNatServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateNatServiceResponse, error) {
+	if err := c.inject(ctx, "CreateNatService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNatServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateNatService", res, err)
+}
+
+func (c *Client) CreateNet(ctx context.Context, req osc.CreateNetRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetResponse, error) {
+	if err := c.inject(ctx, "CreateNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNet(ctx, req, opts...)
+	return observe(c, "CreateNet", res, err)
+}
+
+func (c *Client) CreateNetAccessPoint(ctx context.Context, req osc.CreateNetAccessPointRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "CreateNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNetAccessPoint(ctx, req, opts...)
+	return observe(c, "CreateNetAccessPoint", res, err)
+}
+
+func (c *Client) CreateNetAccessPointWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "CreateNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNetAccessPointWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateNetAccessPoint", res, err)
+}
+
+func (c *Client) CreateNetPeering(ctx context.Context, req osc.CreateNetPeeringRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetPeeringResponse, error) {
+	if err := c.inject(ctx, "CreateNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNetPeering(ctx, req, opts...)
+	return observe(c, "CreateNetPeering", res, err)
+}
+
+func (c *Client) CreateNetPeeringWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetPeeringResponse, error) {
+	if err := c.inject(ctx, "CreateNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNetPeeringWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateNetPeering", res, err)
+}
+
+func (c *Client) CreateNetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateNetResponse, error) {
+	if err := c.inject(ctx, "CreateNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateNet", res, err)
+}
+
+func (c *Client) CreateNic(ctx context.Context, req osc.CreateNicRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateNicResponse, error) {
+	if err := c.inject(ctx, "CreateNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNic(ctx, req, opts...)
+	return observe(c, "CreateNic", res, err)
+}
+
+func (c *Client) CreateNicWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateNicResponse, error) {
+	if err := c.inject(ctx, "CreateNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateNicWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateNic", res, err)
+}
+
+func (c *Client) CreatePolicy(ctx context.Context, req osc.CreatePolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreatePolicyResponse, error) {
+	if err := c.inject(ctx, "CreatePolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePolicy(ctx, req, opts...)
+	return observe(c, "CreatePolicy", res, err)
+}
+
+func (c *Client) CreatePolicyVersion(ctx context.Context, req osc.CreatePolicyVersionRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreatePolicyVersionResponse, error) {
+	if err := c.inject(ctx, "CreatePolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePolicyVersion(ctx, req, opts...)
+	return observe(c, "CreatePolicyVersion", res, err)
+}
+
+func (c *Client) CreatePolicyVersionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreatePolicyVersionResponse, error) {
+	if err := c.inject(ctx, "CreatePolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePolicyVersionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreatePolicyVersion", res, err)
+}
+
+func (c *Client) CreatePolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreatePolicyResponse, error) {
+	if err := c.inject(ctx, "CreatePolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreatePolicy", res, err)
+}
+
+func (c *Client) CreateProductType(ctx context.Context, req osc.CreateProductTypeRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateProductTypeResponse, error) {
+	if err := c.inject(ctx, "CreateProductType"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateProductType(ctx, req, opts...)
+	return observe(c, "CreateProductType", res, err)
+}
+
+func (c *Client) CreateProductTypeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateProductTypeResponse, error) {
+	if err := c.inject(ctx, "CreateProductType"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateProductTypeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateProductType", res, err)
+}
+
+func (c *Client) CreatePublicIp(ctx context.Context, req osc.CreatePublicIpRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreatePublicIpResponse, error) {
+	if err := c.inject(ctx, "CreatePublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePublicIp(ctx, req, opts...)
+	return observe(c, "CreatePublicIp", res, err)
+}
+
+func (c *Client) CreatePublicIpWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreatePublicIpResponse, error) {
+	if err := c.inject(ctx, "CreatePublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreatePublicIpWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreatePublicIp", res, err)
+}
+
+func (c *Client) CreateRoute(ctx context.Context, req osc.CreateRouteRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateRouteResponse, error) {
+	if err := c.inject(ctx, "CreateRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateRoute(ctx, req, opts...)
+	return observe(c, "CreateRoute", res, err)
+}
+
+func (c *Client) CreateRouteTable(ctx context.Context, req osc.CreateRouteTableRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateRouteTableResponse, error) {
+	if err := c.inject(ctx, "CreateRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateRouteTable(ctx, req, opts...)
+	return observe(c, "CreateRouteTable", res, err)
+}
+
+func (c *Client) CreateRouteTableWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateRouteTableResponse, error) {
+	if err := c.inject(ctx, "CreateRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateRouteTableWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateRouteTable", res, err)
+}
+
+func (c *Client) CreateRouteWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateRouteResponse, error) {
+	if err := c.inject(ctx, "CreateRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateRouteWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateRoute", res, err)
+}
+
+func (c *Client) CreateSecurityGroup(ctx context.Context, req osc.CreateSecurityGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateSecurityGroupResponse, error) {
+	if err := c.inject(ctx, "CreateSecurityGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSecurityGroup(ctx, req, opts...)
+	return observe(c, "CreateSecurityGroup", res, err)
+}
+
+func (c *Client) CreateSecurityGroupRule(ctx context.Context, req osc.CreateSecurityGroupRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateSecurityGroupRuleResponse, error) {
+	if err := c.inject(ctx, "CreateSecurityGroupRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSecurityGroupRule(ctx, req, opts...)
+	return observe(c, "CreateSecurityGroupRule", res, err)
+}
+
+func (c *Client) CreateSecurityGroupRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateSecurityGroupRuleResponse, error) {
+	if err := c.inject(ctx, "CreateSecurityGroupRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSecurityGroupRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateSecurityGroupRule", res, err)
+}
+
+func (c *Client) CreateSecurityGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateSecurityGroupResponse, error) {
+	if err := c.inject(ctx, "CreateSecurityGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSecurityGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateSecurityGroup", res, err)
+}
+
+func (c *Client) CreateServerCertificate(ctx context.Context, req osc.CreateServerCertificateRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateServerCertificateResponse, error) {
+	if err := c.inject(ctx, "CreateServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateServerCertificate(ctx, req, opts...)
+	return observe(c, "CreateServerCertificate", res, err)
+}
+
+func (c *Client) CreateServerCertificateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateServerCertificateResponse, error) {
+	if err := c.inject(ctx, "CreateServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateServerCertificateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateServerCertificate", res, err)
+}
+
+func (c *Client) CreateSnapshot(ctx context.Context, req osc.CreateSnapshotRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateSnapshotResponse, error) {
+	if err := c.inject(ctx, "CreateSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSnapshot(ctx, req, opts...)
+	return observe(c, "CreateSnapshot", res, err)
+}
+
+func (c *Client) CreateSnapshotExportTask(ctx context.Context, req osc.CreateSnapshotExportTaskRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateSnapshotExportTaskResponse, error) {
+	if err := c.inject(ctx, "CreateSnapshotExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSnapshotExportTask(ctx, req, opts...)
+	return observe(c, "CreateSnapshotExportTask", res, err)
+}
+
+func (c *Client) CreateSnapshotExportTaskWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateSnapshotExportTaskResponse, error) {
+	if err := c.inject(ctx, "CreateSnapshotExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSnapshotExportTaskWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateSnapshotExportTask", res, err)
+}
+
+func (c *Client) CreateSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateSnapshotResponse, error) {
+	if err := c.inject(ctx, "CreateSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSnapshotWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateSnapshot", res, err)
+}
+
+func (c *Client) CreateSubnet(ctx context.Context, req osc.CreateSubnetRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateSubnetResponse, error) {
+	if err := c.inject(ctx, "CreateSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSubnet(ctx, req, opts...)
+	return observe(c, "CreateSubnet", res, err)
+}
+
+func (c *Client) CreateSubnetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateSubnetResponse, error) {
+	if err := c.inject(ctx, "CreateSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateSubnetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateSubnet", res, err)
+}
+
+func (c *Client) CreateTags(ctx context.Context, req osc.CreateTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateTagsResponse, error) {
+	if err := c.inject(ctx, "CreateTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateTags(ctx, req, opts...)
+	return observe(c, "CreateTags", res, err)
+}
+
+func (c *Client) CreateTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateTagsResponse, error) {
+	if err := c.inject(ctx, "CreateTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateTags", res, err)
+}
+
+func (c *Client) CreateUser(ctx context.Context, req osc.CreateUserRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateUserResponse, error) {
+	if err := c.inject(ctx, "CreateUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateUser(ctx, req, opts...)
+	return observe(c, "CreateUser", res, err)
+}
+
+func (c *Client) CreateUserGroup(ctx context.Context, req osc.CreateUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateUserGroupResponse, error) {
+	if err := c.inject(ctx, "CreateUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateUserGroup(ctx, req, opts...)
+	return observe(c, "CreateUserGroup", res, err)
+}
+
+func (c *Client) CreateUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateUserGroupResponse, error) {
+	if err := c.inject(ctx, "CreateUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateUserGroup", res, err)
+}
+
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateUserResponse, error) {
+	if err := c.inject(ctx, "CreateUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateUserWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateUser", res, err)
+}
+
+func (c *Client) CreateVirtualGateway(ctx context.Context, req osc.CreateVirtualGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "CreateVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVirtualGateway(ctx, req, opts...)
+	return observe(c, "CreateVirtualGateway", res, err)
+}
+
+func (c *Client) CreateVirtualGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "CreateVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVirtualGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVirtualGateway", res, err)
+}
+
+func (c *Client) CreateVmGroup(ctx context.Context, req osc.CreateVmGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmGroupResponse, error) {
+	if err := c.inject(ctx, "CreateVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVmGroup(ctx, req, opts...)
+	return observe(c, "CreateVmGroup", res, err)
+}
+
+func (c *Client) CreateVmGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmGroupResponse, error) {
+	if err := c.inject(ctx, "CreateVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVmGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVmGroup", res, err)
+}
+
+func (c *Client) CreateVmTemplate(ctx context.Context, req osc.CreateVmTemplateRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmTemplateResponse, error) {
+	if err := c.inject(ctx, "CreateVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVmTemplate(ctx, req, opts...)
+	return observe(c, "CreateVmTemplate", res, err)
+}
+
+func (c *Client) CreateVmTemplateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmTemplateResponse, error) {
+	if err := c.inject(ctx, "CreateVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVmTemplateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVmTemplate", res, err)
+}
+
+func (c *Client) CreateVms(ctx context.Context, req osc.CreateVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmsResponse, error) {
+	if err := c.inject(ctx, "CreateVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVms(ctx, req, opts...)
+	return observe(c, "CreateVms", res, err)
+}
+
+func (c *Client) CreateVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVmsResponse, error) {
+	if err := c.inject(ctx, "CreateVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVms", res, err)
+}
+
+func (c *Client) CreateVolume(ctx context.Context, req osc.CreateVolumeRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVolumeResponse, error) {
+	if err := c.inject(ctx, "CreateVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVolume(ctx, req, opts...)
+	return observe(c, "CreateVolume", res, err)
+}
+
+func (c *Client) CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVolumeResponse, error) {
+	if err := c.inject(ctx, "CreateVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVolumeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVolume", res, err)
+}
+
+func (c *Client) CreateVpnConnection(ctx context.Context, req osc.CreateVpnConnectionRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "CreateVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVpnConnection(ctx, req, opts...)
+	return observe(c, "CreateVpnConnection", res, err)
+}
+
+func (c *Client) CreateVpnConnectionRoute(ctx context.Context, req osc.CreateVpnConnectionRouteRequest, opts ...middleware.MiddlewareChainOption) (*osc.CreateVpnConnectionRouteResponse, error) {
+	if err := c.inject(ctx, "CreateVpnConnectionRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVpnConnectionRoute(ctx, req, opts...)
+	return observe(c, "CreateVpnConnectionRoute", res, err)
+}
+
+func (c *Client) CreateVpnConnectionRouteWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVpnConnectionRouteResponse, error) {
+	if err := c.inject(ctx, "CreateVpnConnectionRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVpnConnectionRouteWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVpnConnectionRoute", res, err)
+}
+
+func (c *Client) CreateVpnConnectionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.CreateVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "CreateVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.CreateVpnConnectionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "CreateVpnConnection", res, err)
+}
+
+func (c *Client) DeleteAccessKey(ctx context.Context, req osc.DeleteAccessKeyRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteAccessKeyResponse, error) {
+	if err := c.inject(ctx, "DeleteAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteAccessKey(ctx, req, opts...)
+	return observe(c, "DeleteAccessKey", res, err)
+}
+
+func (c *Client) DeleteAccessKeyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteAccessKeyResponse, error) {
+	if err := c.inject(ctx, "DeleteAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteAccessKeyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteAccessKey", res, err)
+}
+
+func (c *Client) DeleteApiAccessRule(ctx context.Context, req osc.DeleteApiAccessRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteApiAccessRule(ctx, req, opts...)
+	return observe(c, "DeleteApiAccessRule", res, err)
+}
+
+func (c *Client) DeleteApiAccessRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteApiAccessRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteApiAccessRule", res, err)
+}
+
+func (c *Client) DeleteCa(ctx context.Context, req osc.DeleteCaRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteCaResponse, error) {
+	if err := c.inject(ctx, "DeleteCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteCa(ctx, req, opts...)
+	return observe(c, "DeleteCa", res, err)
+}
+
+func (c *Client) DeleteCaWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteCaResponse, error) {
+	if err := c.inject(ctx, "DeleteCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteCaWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteCa", res, err)
+}
+
+func (c *Client) DeleteClientGateway(ctx context.Context, req osc.DeleteClientGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteClientGatewayResponse, error) {
+	if err := c.inject(ctx, "DeleteClientGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteClientGateway(ctx, req, opts...)
+	return observe(c, "DeleteClientGateway", res, err)
+}
+
+func (c *Client) DeleteClientGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteClientGatewayResponse, error) {
+	if err := c.inject(ctx, "DeleteClientGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteClientGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteClientGateway", res, err)
+}
+
+func (c *Client) DeleteDedicatedGroup(ctx context.Context, req osc.DeleteDedicatedGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDedicatedGroup(ctx, req, opts...)
+	return observe(c, "DeleteDedicatedGroup", res, err)
+}
+
+func (c *Client) DeleteDedicatedGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDedicatedGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteDedicatedGroup", res, err)
+}
+
+func (c *Client) DeleteDhcpOptions(ctx context.Context, req osc.DeleteDhcpOptionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "DeleteDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDhcpOptions(ctx, req, opts...)
+	return observe(c, "DeleteDhcpOptions", res, err)
+}
+
+func (c *Client) DeleteDhcpOptionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "DeleteDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDhcpOptionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteDhcpOptions", res, err)
+}
+
+func (c *Client) DeleteDirectLink(ctx context.Context, req osc.DeleteDirectLinkRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDirectLinkResponse, error) {
+	if err := c.inject(ctx, "DeleteDirectLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDirectLink(ctx, req, opts...)
+	return observe(c, "DeleteDirectLink", res, err)
+}
+
+func (c *Client) DeleteDirectLinkInterface(ctx context.Context, req osc.DeleteDirectLinkInterfaceRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "DeleteDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDirectLinkInterface(ctx, req, opts...)
+	return observe(c, "DeleteDirectLinkInterface", res, err)
+}
+
+func (c *Client) DeleteDirectLinkInterfaceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "DeleteDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDirectLinkInterfaceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteDirectLinkInterface", res, err)
+}
+
+func (c *Client) DeleteDirectLinkWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteDirectLinkResponse, error) {
+	if err := c.inject(ctx, "DeleteDirectLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteDirectLinkWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteDirectLink", res, err)
+}
+
+func (c *Client) DeleteExportTask(ctx context.Context, req osc.DeleteExportTaskRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteExportTaskResponse, error) {
+	if err := c.inject(ctx, "DeleteExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteExportTask(ctx, req, opts...)
+	return observe(c, "DeleteExportTask", res, err)
+}
+
+func (c *Client) DeleteExportTaskWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteExportTaskResponse, error) {
+	if err := c.inject(ctx, "DeleteExportTask"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteExportTaskWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteExportTask", res, err)
+}
+
+func (c *Client) DeleteFlexibleGpu(ctx context.Context, req osc.DeleteFlexibleGpuRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "DeleteFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteFlexibleGpu(ctx, req, opts...)
+	return observe(c, "DeleteFlexibleGpu", res, err)
+}
+
+func (c *Client) DeleteFlexibleGpuWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "DeleteFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteFlexibleGpuWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteFlexibleGpu", res, err)
+}
+
+func (c *Client) DeleteImage(ctx context.Context, req osc.DeleteImageRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteImageResponse, error) {
+	if err := c.inject(ctx, "DeleteImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteImage(ctx, req, opts...)
+	return observe(c, "DeleteImage", res, err)
+}
+
+func (c *Client) DeleteImageWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteImageResponse, error) {
+	if err := c.inject(ctx, "DeleteImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteImageWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteImage", res, err)
+}
+
+func (c *Client) DeleteInternetService(ctx context.Context, req osc.DeleteInternetServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteInternetServiceResponse, error) {
+	if err := c.inject(ctx, "DeleteInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteInternetService(ctx, req, opts...)
+	return observe(c, "DeleteInternetService", res, err)
+}
+
+func (c *Client) DeleteInternetServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteInternetServiceResponse, error) {
+	if err := c.inject(ctx, "DeleteInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteInternetServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteInternetService", res, err)
+}
+
+func (c *Client) DeleteKeypair(ctx context.Context, req osc.DeleteKeypairRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteKeypairResponse, error) {
+	if err := c.inject(ctx, "DeleteKeypair"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteKeypair(ctx, req, opts...)
+	return observe(c, "DeleteKeypair", res, err)
+}
+
+func (c *Client) DeleteKeypairWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteKeypairResponse, error) {
+	if err := c.inject(ctx, "DeleteKeypair"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteKeypairWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteKeypair", res, err)
+}
+
+func (c *Client) DeleteListenerRule(ctx context.Context, req osc.DeleteListenerRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteListenerRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteListenerRule(ctx, req, opts...)
+	return observe(c, "DeleteListenerRule", res, err)
+}
+
+func (c *Client) DeleteListenerRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteListenerRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteListenerRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteListenerRule", res, err)
+}
+
+func (c *Client) DeleteLoadBalancer(ctx context.Context, req osc.DeleteLoadBalancerRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancer(ctx, req, opts...)
+	return observe(c, "DeleteLoadBalancer", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerListeners(ctx context.Context, req osc.DeleteLoadBalancerListenersRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerListenersResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerListeners"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerListeners(ctx, req, opts...)
+	return observe(c, "DeleteLoadBalancerListeners", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerListenersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerListenersResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerListeners"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerListenersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteLoadBalancerListeners", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerPolicy(ctx context.Context, req osc.DeleteLoadBalancerPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerPolicy(ctx, req, opts...)
+	return observe(c, "DeleteLoadBalancerPolicy", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteLoadBalancerPolicy", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerTags(ctx context.Context, req osc.DeleteLoadBalancerTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerTags(ctx, req, opts...)
+	return observe(c, "DeleteLoadBalancerTags", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteLoadBalancerTags", res, err)
+}
+
+func (c *Client) DeleteLoadBalancerWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "DeleteLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteLoadBalancerWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteLoadBalancer", res, err)
+}
+
+func (c *Client) DeleteNatService(ctx context.Context, req osc.DeleteNatServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNatServiceResponse, error) {
+	if err := c.inject(ctx, "DeleteNatService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNatService(ctx, req, opts...)
+	return observe(c, "DeleteNatService", res, err)
+}
+
+func (c *Client) DeleteNatServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNatServiceResponse, error) {
+	if err := c.inject(ctx, "DeleteNatService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNatServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteNatService", res, err)
+}
+
+func (c *Client) DeleteNet(ctx context.Context, req osc.DeleteNetRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetResponse, error) {
+	if err := c.inject(ctx, "DeleteNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNet(ctx, req, opts...)
+	return observe(c, "DeleteNet", res, err)
+}
+
+func (c *Client) DeleteNetAccessPoint(ctx context.Context, req osc.DeleteNetAccessPointRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "DeleteNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNetAccessPoint(ctx, req, opts...)
+	return observe(c, "DeleteNetAccessPoint", res, err)
+}
+
+func (c *Client) DeleteNetAccessPointWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "DeleteNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNetAccessPointWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteNetAccessPoint", res, err)
+}
+
+func (c *Client) DeleteNetPeering(ctx context.Context, req osc.DeleteNetPeeringRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetPeeringResponse, error) {
+	if err := c.inject(ctx, "DeleteNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNetPeering(ctx, req, opts...)
+	return observe(c, "DeleteNetPeering", res, err)
+}
+
+func (c *Client) DeleteNetPeeringWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetPeeringResponse, error) {
+	if err := c.inject(ctx, "DeleteNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNetPeeringWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteNetPeering", res, err)
+}
+
+func (c *Client) DeleteNetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNetResponse, error) {
+	if err := c.inject(ctx, "DeleteNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteNet", res, err)
+}
+
+func (c *Client) DeleteNic(ctx context.Context, req osc.DeleteNicRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNicResponse, error) {
+	if err := c.inject(ctx, "DeleteNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNic(ctx, req, opts...)
+	return observe(c, "DeleteNic", res, err)
+}
+
+func (c *Client) DeleteNicWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteNicResponse, error) {
+	if err := c.inject(ctx, "DeleteNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteNicWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteNic", res, err)
+}
+
+func (c *Client) DeletePolicy(ctx context.Context, req osc.DeletePolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeletePolicyResponse, error) {
+	if err := c.inject(ctx, "DeletePolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePolicy(ctx, req, opts...)
+	return observe(c, "DeletePolicy", res, err)
+}
+
+func (c *Client) DeletePolicyVersion(ctx context.Context, req osc.DeletePolicyVersionRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeletePolicyVersionResponse, error) {
+	if err := c.inject(ctx, "DeletePolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePolicyVersion(ctx, req, opts...)
+	return observe(c, "DeletePolicyVersion", res, err)
+}
+
+func (c *Client) DeletePolicyVersionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeletePolicyVersionResponse, error) {
+	if err := c.inject(ctx, "DeletePolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePolicyVersionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeletePolicyVersion", res, err)
+}
+
+func (c *Client) DeletePolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeletePolicyResponse, error) {
+	if err := c.inject(ctx, "DeletePolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeletePolicy", res, err)
+}
+
+func (c *Client) DeleteProductType(ctx context.Context, req osc.DeleteProductTypeRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteProductTypeResponse, error) {
+	if err := c.inject(ctx, "DeleteProductType"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteProductType(ctx, req, opts...)
+	return observe(c, "DeleteProductType", res, err)
+}
+
+func (c *Client) DeleteProductTypeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteProductTypeResponse, error) {
+	if err := c.inject(ctx, "DeleteProductType"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteProductTypeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteProductType", res, err)
+}
+
+func (c *Client) DeletePublicIp(ctx context.Context, req osc.DeletePublicIpRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeletePublicIpResponse, error) {
+	if err := c.inject(ctx, "DeletePublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePublicIp(ctx, req, opts...)
+	return observe(c, "DeletePublicIp", res, err)
+}
+
+func (c *Client) DeletePublicIpWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeletePublicIpResponse, error) {
+	if err := c.inject(ctx, "DeletePublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeletePublicIpWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeletePublicIp", res, err)
+}
+
+func (c *Client) DeleteRoute(ctx context.Context, req osc.DeleteRouteRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteRouteResponse, error) {
+	if err := c.inject(ctx, "DeleteRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteRoute(ctx, req, opts...)
+	return observe(c, "DeleteRoute", res, err)
+}
+
+func (c *Client) DeleteRouteTable(ctx context.Context, req osc.DeleteRouteTableRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteRouteTableResponse, error) {
+	if err := c.inject(ctx, "DeleteRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteRouteTable(ctx, req, opts...)
+	return observe(c, "DeleteRouteTable", res, err)
+}
+
+func (c *Client) DeleteRouteTableWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteRouteTableResponse, error) {
+	if err := c.inject(ctx, "DeleteRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteRouteTableWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteRouteTable", res, err)
+}
+
+func (c *Client) DeleteRouteWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteRouteResponse, error) {
+	if err := c.inject(ctx, "DeleteRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteRouteWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteRoute", res, err)
+}
+
+func (c *Client) DeleteSecurityGroup(ctx context.Context, req osc.DeleteSecurityGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSecurityGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteSecurityGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSecurityGroup(ctx, req, opts...)
+	return observe(c, "DeleteSecurityGroup", res, err)
+}
+
+func (c *Client) DeleteSecurityGroupRule(ctx context.Context, req osc.DeleteSecurityGroupRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSecurityGroupRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteSecurityGroupRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSecurityGroupRule(ctx, req, opts...)
+	return observe(c, "DeleteSecurityGroupRule", res, err)
+}
+
+func (c *Client) DeleteSecurityGroupRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSecurityGroupRuleResponse, error) {
+	if err := c.inject(ctx, "DeleteSecurityGroupRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSecurityGroupRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteSecurityGroupRule", res, err)
+}
+
+func (c *Client) DeleteSecurityGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSecurityGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteSecurityGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSecurityGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteSecurityGroup", res, err)
+}
+
+func (c *Client) DeleteServerCertificate(ctx context.Context, req osc.DeleteServerCertificateRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteServerCertificateResponse, error) {
+	if err := c.inject(ctx, "DeleteServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteServerCertificate(ctx, req, opts...)
+	return observe(c, "DeleteServerCertificate", res, err)
+}
+
+func (c *Client) DeleteServerCertificateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteServerCertificateResponse, error) {
+	if err := c.inject(ctx, "DeleteServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteServerCertificateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteServerCertificate", res, err)
+}
+
+func (c *Client) DeleteSnapshot(ctx context.Context, req osc.DeleteSnapshotRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSnapshotResponse, error) {
+	if err := c.inject(ctx, "DeleteSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSnapshot(ctx, req, opts...)
+	return observe(c, "DeleteSnapshot", res, err)
+}
+
+func (c *Client) DeleteSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSnapshotResponse, error) {
+	if err := c.inject(ctx, "DeleteSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSnapshotWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteSnapshot", res, err)
+}
+
+func (c *Client) DeleteSubnet(ctx context.Context, req osc.DeleteSubnetRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSubnetResponse, error) {
+	if err := c.inject(ctx, "DeleteSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSubnet(ctx, req, opts...)
+	return observe(c, "DeleteSubnet", res, err)
+}
+
+func (c *Client) DeleteSubnetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteSubnetResponse, error) {
+	if err := c.inject(ctx, "DeleteSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteSubnetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteSubnet", res, err)
+}
+
+func (c *Client) DeleteTags(ctx context.Context, req osc.DeleteTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteTagsResponse, error) {
+	if err := c.inject(ctx, "DeleteTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteTags(ctx, req, opts...)
+	return observe(c, "DeleteTags", res, err)
+}
+
+func (c *Client) DeleteTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteTagsResponse, error) {
+	if err := c.inject(ctx, "DeleteTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteTags", res, err)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, req osc.DeleteUserRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserResponse, error) {
+	if err := c.inject(ctx, "DeleteUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUser(ctx, req, opts...)
+	return observe(c, "DeleteUser", res, err)
+}
+
+func (c *Client) DeleteUserGroup(ctx context.Context, req osc.DeleteUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserGroup(ctx, req, opts...)
+	return observe(c, "DeleteUserGroup", res, err)
+}
+
+func (c *Client) DeleteUserGroupPolicy(ctx context.Context, req osc.DeleteUserGroupPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserGroupPolicy(ctx, req, opts...)
+	return observe(c, "DeleteUserGroupPolicy", res, err)
+}
+
+func (c *Client) DeleteUserGroupPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserGroupPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteUserGroupPolicy", res, err)
+}
+
+func (c *Client) DeleteUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteUserGroup", res, err)
+}
+
+func (c *Client) DeleteUserPolicy(ctx context.Context, req osc.DeleteUserPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserPolicy(ctx, req, opts...)
+	return observe(c, "DeleteUserPolicy", res, err)
+}
+
+func (c *Client) DeleteUserPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserPolicyResponse, error) {
+	if err := c.inject(ctx, "DeleteUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteUserPolicy", res, err)
+}
+
+func (c *Client) DeleteUserWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteUserResponse, error) {
+	if err := c.inject(ctx, "DeleteUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteUserWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteUser", res, err)
+}
+
+func (c *Client) DeleteVirtualGateway(ctx context.Context, req osc.DeleteVirtualGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "DeleteVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVirtualGateway(ctx, req, opts...)
+	return observe(c, "DeleteVirtualGateway", res, err)
+}
+
+func (c *Client) DeleteVirtualGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "DeleteVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVirtualGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVirtualGateway", res, err)
+}
+
+func (c *Client) DeleteVmGroup(ctx context.Context, req osc.DeleteVmGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVmGroup(ctx, req, opts...)
+	return observe(c, "DeleteVmGroup", res, err)
+}
+
+func (c *Client) DeleteVmGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmGroupResponse, error) {
+	if err := c.inject(ctx, "DeleteVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVmGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVmGroup", res, err)
+}
+
+func (c *Client) DeleteVmTemplate(ctx context.Context, req osc.DeleteVmTemplateRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmTemplateResponse, error) {
+	if err := c.inject(ctx, "DeleteVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVmTemplate(ctx, req, opts...)
+	return observe(c, "DeleteVmTemplate", res, err)
+}
+
+func (c *Client) DeleteVmTemplateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmTemplateResponse, error) {
+	if err := c.inject(ctx, "DeleteVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVmTemplateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVmTemplate", res, err)
+}
+
+func (c *Client) DeleteVms(ctx context.Context, req osc.DeleteVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmsResponse, error) {
+	if err := c.inject(ctx, "DeleteVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVms(ctx, req, opts...)
+	return observe(c, "DeleteVms", res, err)
+}
+
+func (c *Client) DeleteVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVmsResponse, error) {
+	if err := c.inject(ctx, "DeleteVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVms", res, err)
+}
+
+func (c *Client) DeleteVolume(ctx context.Context, req osc.DeleteVolumeRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVolumeResponse, error) {
+	if err := c.inject(ctx, "DeleteVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVolume(ctx, req, opts...)
+	return observe(c, "DeleteVolume", res, err)
+}
+
+func (c *Client) DeleteVolumeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVolumeResponse, error) {
+	if err := c.inject(ctx, "DeleteVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVolumeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVolume", res, err)
+}
+
+func (c *Client) DeleteVpnConnection(ctx context.Context, req osc.DeleteVpnConnectionRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "DeleteVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVpnConnection(ctx, req, opts...)
+	return observe(c, "DeleteVpnConnection", res, err)
+}
+
+func (c *Client) DeleteVpnConnectionRoute(ctx context.Context, req osc.DeleteVpnConnectionRouteRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVpnConnectionRouteResponse, error) {
+	if err := c.inject(ctx, "DeleteVpnConnectionRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVpnConnectionRoute(ctx, req, opts...)
+	return observe(c, "DeleteVpnConnectionRoute", res, err)
+}
+
+func (c *Client) DeleteVpnConnectionRouteWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVpnConnectionRouteResponse, error) {
+	if err := c.inject(ctx, "DeleteVpnConnectionRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVpnConnectionRouteWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVpnConnectionRoute", res, err)
+}
+
+func (c *Client) DeleteVpnConnectionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeleteVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "DeleteVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeleteVpnConnectionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeleteVpnConnection", res, err)
+}
+
+func (c *Client) DeregisterVmsInLoadBalancer(ctx context.Context, req osc.DeregisterVmsInLoadBalancerRequest, opts ...middleware.MiddlewareChainOption) (*osc.DeregisterVmsInLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "DeregisterVmsInLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeregisterVmsInLoadBalancer(ctx, req, opts...)
+	return observe(c, "DeregisterVmsInLoadBalancer", res, err)
+}
+
+func (c *Client) DeregisterVmsInLoadBalancerWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DeregisterVmsInLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "DeregisterVmsInLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DeregisterVmsInLoadBalancerWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DeregisterVmsInLoadBalancer", res, err)
+}
+
+func (c *Client) DisableOutscaleLogin(ctx context.Context, req osc.DisableOutscaleLoginRequest, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLogin"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLogin(ctx, req, opts...)
+	return observe(c, "DisableOutscaleLogin", res, err)
+}
+
+func (c *Client) DisableOutscaleLoginForUsers(ctx context.Context, req osc.DisableOutscaleLoginRequest, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLoginForUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLoginForUsers(ctx, req, opts...)
+	return observe(c, "DisableOutscaleLoginForUsers", res, err)
+}
+
+func (c *Client) DisableOutscaleLoginForUsersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLoginForUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLoginForUsersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DisableOutscaleLoginForUsers", res, err)
+}
+
+func (c *Client) DisableOutscaleLoginPerUsers(ctx context.Context, req osc.DisableOutscaleLoginPerUsersRequest, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginPerUsersResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLoginPerUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLoginPerUsers(ctx, req, opts...)
+	return observe(c, "DisableOutscaleLoginPerUsers", res, err)
+}
+
+func (c *Client) DisableOutscaleLoginPerUsersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginPerUsersResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLoginPerUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLoginPerUsersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DisableOutscaleLoginPerUsers", res, err)
+}
+
+func (c *Client) DisableOutscaleLoginWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.DisableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "DisableOutscaleLogin"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.DisableOutscaleLoginWithBody(ctx, contentType, body, opts...)
+	return observe(c, "DisableOutscaleLogin", res, err)
+}
+
+func (c *Client) EnableOutscaleLogin(ctx context.Context, req osc.EnableOutscaleLoginRequest, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLogin"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLogin(ctx, req, opts...)
+	return observe(c, "EnableOutscaleLogin", res, err)
+}
+
+func (c *Client) EnableOutscaleLoginForUsers(ctx context.Context, req osc.EnableOutscaleLoginForUsersRequest, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginForUsersResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLoginForUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLoginForUsers(ctx, req, opts...)
+	return observe(c, "EnableOutscaleLoginForUsers", res, err)
+}
+
+func (c *Client) EnableOutscaleLoginForUsersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginForUsersResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLoginForUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLoginForUsersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "EnableOutscaleLoginForUsers", res, err)
+}
+
+func (c *Client) EnableOutscaleLoginPerUsers(ctx context.Context, req osc.EnableOutscaleLoginPerUsersRequest, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginPerUsersResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLoginPerUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLoginPerUsers(ctx, req, opts...)
+	return observe(c, "EnableOutscaleLoginPerUsers", res, err)
+}
+
+func (c *Client) EnableOutscaleLoginPerUsersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginPerUsersResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLoginPerUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLoginPerUsersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "EnableOutscaleLoginPerUsers", res, err)
+}
+
+func (c *Client) EnableOutscaleLoginWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.EnableOutscaleLoginResponse, error) {
+	if err := c.inject(ctx, "EnableOutscaleLogin"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.EnableOutscaleLoginWithBody(ctx, contentType, body, opts...)
+	return observe(c, "EnableOutscaleLogin", res, err)
+}
+
+func (c *Client) LinkFlexibleGpu(ctx context.Context, req osc.LinkFlexibleGpuRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "LinkFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkFlexibleGpu(ctx, req, opts...)
+	return observe(c, "LinkFlexibleGpu", res, err)
+}
+
+func (c *Client) LinkFlexibleGpuWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "LinkFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkFlexibleGpuWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkFlexibleGpu", res, err)
+}
+
+func (c *Client) LinkInternetService(ctx context.Context, req osc.LinkInternetServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkInternetServiceResponse, error) {
+	if err := c.inject(ctx, "LinkInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkInternetService(ctx, req, opts...)
+	return observe(c, "LinkInternetService", res, err)
+}
+
+func (c *Client) LinkInternetServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkInternetServiceResponse, error) {
+	if err := c.inject(ctx, "LinkInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkInternetServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkInternetService", res, err)
+}
+
+func (c *Client) LinkLoadBalancerBackendMachines(ctx context.Context, req osc.LinkLoadBalancerBackendMachinesRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkLoadBalancerBackendMachinesResponse, error) {
+	if err := c.inject(ctx, "LinkLoadBalancerBackendMachines"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkLoadBalancerBackendMachines(ctx, req, opts...)
+	return observe(c, "LinkLoadBalancerBackendMachines", res, err)
+}
+
+func (c *Client) LinkLoadBalancerBackendMachinesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkLoadBalancerBackendMachinesResponse, error) {
+	if err := c.inject(ctx, "LinkLoadBalancerBackendMachines"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkLoadBalancerBackendMachinesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkLoadBalancerBackendMachines", res, err)
+}
+
+func (c *Client) LinkManagedPolicyToUserGroup(ctx context.Context, req osc.LinkManagedPolicyToUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkManagedPolicyToUserGroupResponse, error) {
+	if err := c.inject(ctx, "LinkManagedPolicyToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkManagedPolicyToUserGroup(ctx, req, opts...)
+	return observe(c, "LinkManagedPolicyToUserGroup", res, err)
+}
+
+func (c *Client) LinkManagedPolicyToUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkManagedPolicyToUserGroupResponse, error) {
+	if err := c.inject(ctx, "LinkManagedPolicyToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkManagedPolicyToUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkManagedPolicyToUserGroup", res, err)
+}
+
+func (c *Client) LinkNic(ctx context.Context, req osc.LinkNicRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkNicResponse, error) {
+	if err := c.inject(ctx, "LinkNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkNic(ctx, req, opts...)
+	return observe(c, "LinkNic", res, err)
+}
+
+func (c *Client) LinkNicWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkNicResponse, error) {
+	if err := c.inject(ctx, "LinkNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkNicWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkNic", res, err)
+}
+
+func (c *Client) LinkPolicy(ctx context.Context, req osc.LinkPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkPolicyResponse, error) {
+	if err := c.inject(ctx, "LinkPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPolicy(ctx, req, opts...)
+	return observe(c, "LinkPolicy", res, err)
+}
+
+func (c *Client) LinkPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkPolicyResponse, error) {
+	if err := c.inject(ctx, "LinkPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkPolicy", res, err)
+}
+
+func (c *Client) LinkPrivateIps(ctx context.Context, req osc.LinkPrivateIpsRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkPrivateIpsResponse, error) {
+	if err := c.inject(ctx, "LinkPrivateIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPrivateIps(ctx, req, opts...)
+	return observe(c, "LinkPrivateIps", res, err)
+}
+
+func (c *Client) LinkPrivateIpsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkPrivateIpsResponse, error) {
+	if err := c.inject(ctx, "LinkPrivateIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPrivateIpsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkPrivateIps", res, err)
+}
+
+func (c *Client) LinkPublicIp(ctx context.Context, req osc.LinkPublicIpRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkPublicIpResponse, error) {
+	if err := c.inject(ctx, "LinkPublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPublicIp(ctx, req, opts...)
+	return observe(c, "LinkPublicIp", res, err)
+}
+
+func (c *Client) LinkPublicIpWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkPublicIpResponse, error) {
+	if err := c.inject(ctx, "LinkPublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkPublicIpWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkPublicIp", res, err)
+}
+
+func (c *Client) LinkRouteTable(ctx context.Context, req osc.LinkRouteTableRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkRouteTableResponse, error) {
+	if err := c.inject(ctx, "LinkRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkRouteTable(ctx, req, opts...)
+	return observe(c, "LinkRouteTable", res, err)
+}
+
+func (c *Client) LinkRouteTableWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkRouteTableResponse, error) {
+	if err := c.inject(ctx, "LinkRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkRouteTableWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkRouteTable", res, err)
+}
+
+func (c *Client) LinkVirtualGateway(ctx context.Context, req osc.LinkVirtualGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "LinkVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkVirtualGateway(ctx, req, opts...)
+	return observe(c, "LinkVirtualGateway", res, err)
+}
+
+func (c *Client) LinkVirtualGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "LinkVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkVirtualGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkVirtualGateway", res, err)
+}
+
+func (c *Client) LinkVolume(ctx context.Context, req osc.LinkVolumeRequest, opts ...middleware.MiddlewareChainOption) (*osc.LinkVolumeResponse, error) {
+	if err := c.inject(ctx, "LinkVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkVolume(ctx, req, opts...)
+	return observe(c, "LinkVolume", res, err)
+}
+
+func (c *Client) LinkVolumeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.LinkVolumeResponse, error) {
+	if err := c.inject(ctx, "LinkVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.LinkVolumeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "LinkVolume", res, err)
+}
+
+func (c *Client) PutUserGroupPolicy(ctx context.Context, req osc.PutUserGroupPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.PutUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "PutUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.PutUserGroupPolicy(ctx, req, opts...)
+	return observe(c, "PutUserGroupPolicy", res, err)
+}
+
+func (c *Client) PutUserGroupPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.PutUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "PutUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.PutUserGroupPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "PutUserGroupPolicy", res, err)
+}
+
+func (c *Client) PutUserPolicy(ctx context.Context, req osc.PutUserPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.PutUserPolicyResponse, error) {
+	if err := c.inject(ctx, "PutUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.PutUserPolicy(ctx, req, opts...)
+	return observe(c, "PutUserPolicy", res, err)
+}
+
+func (c *Client) PutUserPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.PutUserPolicyResponse, error) {
+	if err := c.inject(ctx, "PutUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.PutUserPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "PutUserPolicy", res, err)
+}
+
+func (c *Client) ReadAccessKeys(ctx context.Context, req osc.ReadAccessKeysRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadAccessKeysResponse, error) {
+	if err := c.inject(ctx, "ReadAccessKeys"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAccessKeys(ctx, req, opts...)
+	return observe(c, "ReadAccessKeys", res, err)
+}
+
+func (c *Client) ReadAccessKeysWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadAccessKeysResponse, error) {
+	if err := c.inject(ctx, "ReadAccessKeys"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAccessKeysWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadAccessKeys", res, err)
+}
+
+func (c *Client) ReadAccounts(ctx context.Context, req osc.ReadAccountsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadAccountsResponse, error) {
+	if err := c.inject(ctx, "ReadAccounts"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAccounts(ctx, req, opts...)
+	return observe(c, "ReadAccounts", res, err)
+}
+
+func (c *Client) ReadAccountsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadAccountsResponse, error) {
+	if err := c.inject(ctx, "ReadAccounts"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAccountsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadAccounts", res, err)
+}
+
+func (c *Client) ReadAdminPassword(ctx context.Context, req osc.ReadAdminPasswordRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadAdminPasswordResponse, error) {
+	if err := c.inject(ctx, "ReadAdminPassword"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAdminPassword(ctx, req, opts...)
+	return observe(c, "ReadAdminPassword", res, err)
+}
+
+func (c *Client) ReadAdminPasswordWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadAdminPasswordResponse, error) {
+	if err := c.inject(ctx, "ReadAdminPassword"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadAdminPasswordWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadAdminPassword", res, err)
+}
+
+func (c *Client) ReadApiAccessPolicy(ctx context.Context, req osc.ReadApiAccessPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiAccessPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadApiAccessPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiAccessPolicy(ctx, req, opts...)
+	return observe(c, "ReadApiAccessPolicy", res, err)
+}
+
+func (c *Client) ReadApiAccessPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiAccessPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadApiAccessPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiAccessPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadApiAccessPolicy", res, err)
+}
+
+func (c *Client) ReadApiAccessRules(ctx context.Context, req osc.ReadApiAccessRulesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiAccessRulesResponse, error) {
+	if err := c.inject(ctx, "ReadApiAccessRules"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiAccessRules(ctx, req, opts...)
+	return observe(c, "ReadApiAccessRules", res, err)
+}
+
+func (c *Client) ReadApiAccessRulesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiAccessRulesResponse, error) {
+	if err := c.inject(ctx, "ReadApiAccessRules"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiAccessRulesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadApiAccessRules", res, err)
+}
+
+func (c *Client) ReadApiLogs(ctx context.Context, req osc.ReadApiLogsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiLogsResponse, error) {
+	if err := c.inject(ctx, "ReadApiLogs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiLogs(ctx, req, opts...)
+	return observe(c, "ReadApiLogs", res, err)
+}
+
+func (c *Client) ReadApiLogsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadApiLogsResponse, error) {
+	if err := c.inject(ctx, "ReadApiLogs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadApiLogsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadApiLogs", res, err)
+}
+
+func (c *Client) ReadCO2EmissionAccount(ctx context.Context, req osc.ReadCO2EmissionAccountRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadCO2EmissionAccountResponse, error) {
+	if err := c.inject(ctx, "ReadCO2EmissionAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCO2EmissionAccount(ctx, req, opts...)
+	return observe(c, "ReadCO2EmissionAccount", res, err)
+}
+
+func (c *Client) ReadCO2EmissionAccountWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadCO2EmissionAccountResponse, error) {
+	if err := c.inject(ctx, "ReadCO2EmissionAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCO2EmissionAccountWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadCO2EmissionAccount", res, err)
+}
+
+func (c *Client) ReadCas(ctx context.Context, req osc.ReadCasRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadCasResponse, error) {
+	if err := c.inject(ctx, "ReadCas"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCas(ctx, req, opts...)
+	return observe(c, "ReadCas", res, err)
+}
+
+func (c *Client) ReadCasWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadCasResponse, error) {
+	if err := c.inject(ctx, "ReadCas"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCasWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadCas", res, err)
+}
+
+func (c *Client) ReadCatalog(ctx context.Context, req osc.ReadCatalogRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCatalog(ctx, req, opts...)
+	return observe(c, "ReadCatalog", res, err)
+}
+
+func (c *Client) ReadCatalogWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCatalogWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadCatalog", res, err)
+}
+
+func (c *Client) ReadCatalogs(ctx context.Context, req osc.ReadCatalogsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadCatalogsResponse, error) {
+	if err := c.inject(ctx, "ReadCatalogs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCatalogs(ctx, req, opts...)
+	return observe(c, "ReadCatalogs", res, err)
+}
+
+func (c *Client) ReadCatalogsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadCatalogsResponse, error) {
+	if err := c.inject(ctx, "ReadCatalogs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadCatalogsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadCatalogs", res, err)
+}
+
+func (c *Client) ReadClientGateways(ctx context.Context, req osc.ReadClientGatewaysRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadClientGatewaysResponse, error) {
+	if err := c.inject(ctx, "ReadClientGateways"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadClientGateways(ctx, req, opts...)
+	return observe(c, "ReadClientGateways", res, err)
+}
+
+func (c *Client) ReadClientGatewaysWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadClientGatewaysResponse, error) {
+	if err := c.inject(ctx, "ReadClientGateways"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadClientGatewaysWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadClientGateways", res, err)
+}
+
+func (c *Client) ReadConsoleOutput(ctx context.Context, req osc.ReadConsoleOutputRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadConsoleOutputResponse, error) {
+	if err := c.inject(ctx, "ReadConsoleOutput"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadConsoleOutput(ctx, req, opts...)
+	return observe(c, "ReadConsoleOutput", res, err)
+}
+
+func (c *Client) ReadConsoleOutputWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadConsoleOutputResponse, error) {
+	if err := c.inject(ctx, "ReadConsoleOutput"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadConsoleOutputWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadConsoleOutput", res, err)
+}
+
+func (c *Client) ReadConsumptionAccount(ctx context.Context, req osc.ReadConsumptionAccountRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadConsumptionAccountResponse, error) {
+	if err := c.inject(ctx, "ReadConsumptionAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadConsumptionAccount(ctx, req, opts...)
+	return observe(c, "ReadConsumptionAccount", res, err)
+}
+
+func (c *Client) ReadConsumptionAccountWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadConsumptionAccountResponse, error) {
+	if err := c.inject(ctx, "ReadConsumptionAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadConsumptionAccountWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadConsumptionAccount", res, err)
+}
+
+func (c *Client) ReadDedicatedGroups(ctx context.Context, req osc.ReadDedicatedGroupsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadDedicatedGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadDedicatedGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDedicatedGroups(ctx, req, opts...)
+	return observe(c, "ReadDedicatedGroups", res, err)
+}
+
+func (c *Client) ReadDedicatedGroupsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadDedicatedGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadDedicatedGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDedicatedGroupsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadDedicatedGroups", res, err)
+}
+
+func (c *Client) ReadDhcpOptions(ctx context.Context, req osc.ReadDhcpOptionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "ReadDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDhcpOptions(ctx, req, opts...)
+	return observe(c, "ReadDhcpOptions", res, err)
+}
+
+func (c *Client) ReadDhcpOptionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadDhcpOptionsResponse, error) {
+	if err := c.inject(ctx, "ReadDhcpOptions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDhcpOptionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadDhcpOptions", res, err)
+}
+
+func (c *Client) ReadDirectLinkInterfaces(ctx context.Context, req osc.ReadDirectLinkInterfacesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadDirectLinkInterfacesResponse, error) {
+	if err := c.inject(ctx, "ReadDirectLinkInterfaces"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDirectLinkInterfaces(ctx, req, opts...)
+	return observe(c, "ReadDirectLinkInterfaces", res, err)
+}
+
+func (c *Client) ReadDirectLinkInterfacesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadDirectLinkInterfacesResponse, error) {
+	if err := c.inject(ctx, "ReadDirectLinkInterfaces"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDirectLinkInterfacesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadDirectLinkInterfaces", res, err)
+}
+
+func (c *Client) ReadDirectLinks(ctx context.Context, req osc.ReadDirectLinksRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadDirectLinksResponse, error) {
+	if err := c.inject(ctx, "ReadDirectLinks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDirectLinks(ctx, req, opts...)
+	return observe(c, "ReadDirectLinks", res, err)
+}
+
+func (c *Client) ReadDirectLinksWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadDirectLinksResponse, error) {
+	if err := c.inject(ctx, "ReadDirectLinks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadDirectLinksWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadDirectLinks", res, err)
+}
+
+func (c *Client) ReadEntitiesLinkedToPolicy(ctx context.Context, req osc.ReadEntitiesLinkedToPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadEntitiesLinkedToPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadEntitiesLinkedToPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadEntitiesLinkedToPolicy(ctx, req, opts...)
+	return observe(c, "ReadEntitiesLinkedToPolicy", res, err)
+}
+
+func (c *Client) ReadEntitiesLinkedToPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadEntitiesLinkedToPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadEntitiesLinkedToPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadEntitiesLinkedToPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadEntitiesLinkedToPolicy", res, err)
+}
+
+func (c *Client) ReadFlexibleGpuCatalog(ctx context.Context, req osc.ReadFlexibleGpuCatalogRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadFlexibleGpuCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadFlexibleGpuCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadFlexibleGpuCatalog(ctx, req, opts...)
+	return observe(c, "ReadFlexibleGpuCatalog", res, err)
+}
+
+func (c *Client) ReadFlexibleGpuCatalogWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadFlexibleGpuCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadFlexibleGpuCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadFlexibleGpuCatalogWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadFlexibleGpuCatalog", res, err)
+}
+
+func (c *Client) ReadFlexibleGpus(ctx context.Context, req osc.ReadFlexibleGpusRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadFlexibleGpusResponse, error) {
+	if err := c.inject(ctx, "ReadFlexibleGpus"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadFlexibleGpus(ctx, req, opts...)
+	return observe(c, "ReadFlexibleGpus", res, err)
+}
+
+func (c *Client) ReadFlexibleGpusWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadFlexibleGpusResponse, error) {
+	if err := c.inject(ctx, "ReadFlexibleGpus"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadFlexibleGpusWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadFlexibleGpus", res, err)
+}
+
+func (c *Client) ReadImageExportTasks(ctx context.Context, req osc.ReadImageExportTasksRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadImageExportTasksResponse, error) {
+	if err := c.inject(ctx, "ReadImageExportTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadImageExportTasks(ctx, req, opts...)
+	return observe(c, "ReadImageExportTasks", res, err)
+}
+
+func (c *Client) ReadImageExportTasksWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadImageExportTasksResponse, error) {
+	if err := c.inject(ctx, "ReadImageExportTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadImageExportTasksWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadImageExportTasks", res, err)
+}
+
+func (c *Client) ReadImages(ctx context.Context, req osc.ReadImagesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadImagesResponse, error) {
+	if err := c.inject(ctx, "ReadImages"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadImages(ctx, req, opts...)
+	return observe(c, "ReadImages", res, err)
+}
+
+func (c *Client) ReadImagesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadImagesResponse, error) {
+	if err := c.inject(ctx, "ReadImages"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadImagesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadImages", res, err)
+}
+
+func (c *Client) ReadInternetServices(ctx context.Context, req osc.ReadInternetServicesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadInternetServicesResponse, error) {
+	if err := c.inject(ctx, "ReadInternetServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadInternetServices(ctx, req, opts...)
+	return observe(c, "ReadInternetServices", res, err)
+}
+
+func (c *Client) ReadInternetServicesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadInternetServicesResponse, error) {
+	if err := c.inject(ctx, "ReadInternetServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadInternetServicesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadInternetServices", res, err)
+}
+
+func (c *Client) ReadKeypairs(ctx context.Context, req osc.ReadKeypairsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadKeypairsResponse, error) {
+	if err := c.inject(ctx, "ReadKeypairs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadKeypairs(ctx, req, opts...)
+	return observe(c, "ReadKeypairs", res, err)
+}
+
+func (c *Client) ReadKeypairsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadKeypairsResponse, error) {
+	if err := c.inject(ctx, "ReadKeypairs"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadKeypairsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadKeypairs", res, err)
+}
+
+func (c *Client) ReadLinkedPolicies(ctx context.Context, req osc.ReadLinkedPoliciesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadLinkedPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadLinkedPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLinkedPolicies(ctx, req, opts...)
+	return observe(c, "ReadLinkedPolicies", res, err)
+}
+
+func (c *Client) ReadLinkedPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadLinkedPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadLinkedPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLinkedPoliciesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadLinkedPolicies", res, err)
+}
+
+func (c *Client) ReadListenerRules(ctx context.Context, req osc.ReadListenerRulesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadListenerRulesResponse, error) {
+	if err := c.inject(ctx, "ReadListenerRules"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadListenerRules(ctx, req, opts...)
+	return observe(c, "ReadListenerRules", res, err)
+}
+
+func (c *Client) ReadListenerRulesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadListenerRulesResponse, error) {
+	if err := c.inject(ctx, "ReadListenerRules"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadListenerRulesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadListenerRules", res, err)
+}
+
+func (c *Client) ReadLoadBalancerTags(ctx context.Context, req osc.ReadLoadBalancerTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "ReadLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLoadBalancerTags(ctx, req, opts...)
+	return observe(c, "ReadLoadBalancerTags", res, err)
+}
+
+func (c *Client) ReadLoadBalancerTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancerTagsResponse, error) {
+	if err := c.inject(ctx, "ReadLoadBalancerTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLoadBalancerTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadLoadBalancerTags", res, err)
+}
+
+func (c *Client) ReadLoadBalancers(ctx context.Context, req osc.ReadLoadBalancersRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancersResponse, error) {
+	if err := c.inject(ctx, "ReadLoadBalancers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLoadBalancers(ctx, req, opts...)
+	return observe(c, "ReadLoadBalancers", res, err)
+}
+
+func (c *Client) ReadLoadBalancersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancersResponse, error) {
+	if err := c.inject(ctx, "ReadLoadBalancers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLoadBalancersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadLoadBalancers", res, err)
+}
+
+func (c *Client) ReadLocations(ctx context.Context, req osc.ReadLocationsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadLocationsResponse, error) {
+	if err := c.inject(ctx, "ReadLocations"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLocations(ctx, req, opts...)
+	return observe(c, "ReadLocations", res, err)
+}
+
+func (c *Client) ReadLocationsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadLocationsResponse, error) {
+	if err := c.inject(ctx, "ReadLocations"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadLocationsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadLocations", res, err)
+}
+
+func (c *Client) ReadManagedPoliciesLinkedToUserGroup(ctx context.Context, req osc.ReadManagedPoliciesLinkedToUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadManagedPoliciesLinkedToUserGroupResponse, error) {
+	if err := c.inject(ctx, "ReadManagedPoliciesLinkedToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadManagedPoliciesLinkedToUserGroup(ctx, req, opts...)
+	return observe(c, "ReadManagedPoliciesLinkedToUserGroup", res, err)
+}
+
+func (c *Client) ReadManagedPoliciesLinkedToUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadManagedPoliciesLinkedToUserGroupResponse, error) {
+	if err := c.inject(ctx, "ReadManagedPoliciesLinkedToUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadManagedPoliciesLinkedToUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadManagedPoliciesLinkedToUserGroup", res, err)
+}
+
+func (c *Client) ReadNatServices(ctx context.Context, req osc.ReadNatServicesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNatServicesResponse, error) {
+	if err := c.inject(ctx, "ReadNatServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNatServices(ctx, req, opts...)
+	return observe(c, "ReadNatServices", res, err)
+}
+
+func (c *Client) ReadNatServicesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNatServicesResponse, error) {
+	if err := c.inject(ctx, "ReadNatServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNatServicesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNatServices", res, err)
+}
+
+func (c *Client) ReadNetAccessPointServices(ctx context.Context, req osc.ReadNetAccessPointServicesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetAccessPointServicesResponse, error) {
+	if err := c.inject(ctx, "ReadNetAccessPointServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetAccessPointServices(ctx, req, opts...)
+	return observe(c, "ReadNetAccessPointServices", res, err)
+}
+
+func (c *Client) ReadNetAccessPointServicesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetAccessPointServicesResponse, error) {
+	if err := c.inject(ctx, "ReadNetAccessPointServices"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetAccessPointServicesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNetAccessPointServices", res, err)
+}
+
+func (c *Client) ReadNetAccessPoints(ctx context.Context, req osc.ReadNetAccessPointsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetAccessPointsResponse, error) {
+	if err := c.inject(ctx, "ReadNetAccessPoints"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetAccessPoints(ctx, req, opts...)
+	return observe(c, "ReadNetAccessPoints", res, err)
+}
+
+func (c *Client) ReadNetAccessPointsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetAccessPointsResponse, error) {
+	if err := c.inject(ctx, "ReadNetAccessPoints"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetAccessPointsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNetAccessPoints", res, err)
+}
+
+func (c *Client) ReadNetPeerings(ctx context.Context, req osc.ReadNetPeeringsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetPeeringsResponse, error) {
+	if err := c.inject(ctx, "ReadNetPeerings"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetPeerings(ctx, req, opts...)
+	return observe(c, "ReadNetPeerings", res, err)
+}
+
+func (c *Client) ReadNetPeeringsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetPeeringsResponse, error) {
+	if err := c.inject(ctx, "ReadNetPeerings"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetPeeringsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNetPeerings", res, err)
+}
+
+func (c *Client) ReadNets(ctx context.Context, req osc.ReadNetsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetsResponse, error) {
+	if err := c.inject(ctx, "ReadNets"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNets(ctx, req, opts...)
+	return observe(c, "ReadNets", res, err)
+}
+
+func (c *Client) ReadNetsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNetsResponse, error) {
+	if err := c.inject(ctx, "ReadNets"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNetsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNets", res, err)
+}
+
+func (c *Client) ReadNics(ctx context.Context, req osc.ReadNicsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadNicsResponse, error) {
+	if err := c.inject(ctx, "ReadNics"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNics(ctx, req, opts...)
+	return observe(c, "ReadNics", res, err)
+}
+
+func (c *Client) ReadNicsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadNicsResponse, error) {
+	if err := c.inject(ctx, "ReadNics"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadNicsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadNics", res, err)
+}
+
+func (c *Client) ReadPolicies(ctx context.Context, req osc.ReadPoliciesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicies(ctx, req, opts...)
+	return observe(c, "ReadPolicies", res, err)
+}
+
+func (c *Client) ReadPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPoliciesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPolicies", res, err)
+}
+
+func (c *Client) ReadPolicy(ctx context.Context, req osc.ReadPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicy(ctx, req, opts...)
+	return observe(c, "ReadPolicy", res, err)
+}
+
+func (c *Client) ReadPolicyVersion(ctx context.Context, req osc.ReadPolicyVersionRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyVersionResponse, error) {
+	if err := c.inject(ctx, "ReadPolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicyVersion(ctx, req, opts...)
+	return observe(c, "ReadPolicyVersion", res, err)
+}
+
+func (c *Client) ReadPolicyVersionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyVersionResponse, error) {
+	if err := c.inject(ctx, "ReadPolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicyVersionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPolicyVersion", res, err)
+}
+
+func (c *Client) ReadPolicyVersions(ctx context.Context, req osc.ReadPolicyVersionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyVersionsResponse, error) {
+	if err := c.inject(ctx, "ReadPolicyVersions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicyVersions(ctx, req, opts...)
+	return observe(c, "ReadPolicyVersions", res, err)
+}
+
+func (c *Client) ReadPolicyVersionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyVersionsResponse, error) {
+	if err := c.inject(ctx, "ReadPolicyVersions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicyVersionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPolicyVersions", res, err)
+}
+
+func (c *Client) ReadPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPolicy", res, err)
+}
+
+func (c *Client) ReadProductTypes(ctx context.Context, req osc.ReadProductTypesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadProductTypesResponse, error) {
+	if err := c.inject(ctx, "ReadProductTypes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadProductTypes(ctx, req, opts...)
+	return observe(c, "ReadProductTypes", res, err)
+}
+
+func (c *Client) ReadProductTypesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadProductTypesResponse, error) {
+	if err := c.inject(ctx, "ReadProductTypes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadProductTypesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadProductTypes", res, err)
+}
+
+func (c *Client) ReadPublicCatalog(ctx context.Context, req osc.ReadPublicCatalogRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadPublicCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicCatalog(ctx, req, opts...)
+	return observe(c, "ReadPublicCatalog", res, err)
+}
+
+func (c *Client) ReadPublicCatalogWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicCatalogResponse, error) {
+	if err := c.inject(ctx, "ReadPublicCatalog"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicCatalogWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPublicCatalog", res, err)
+}
+
+func (c *Client) ReadPublicIpRanges(ctx context.Context, req osc.ReadPublicIpRangesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpRangesResponse, error) {
+	if err := c.inject(ctx, "ReadPublicIpRanges"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicIpRanges(ctx, req, opts...)
+	return observe(c, "ReadPublicIpRanges", res, err)
+}
+
+func (c *Client) ReadPublicIpRangesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpRangesResponse, error) {
+	if err := c.inject(ctx, "ReadPublicIpRanges"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicIpRangesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPublicIpRanges", res, err)
+}
+
+func (c *Client) ReadPublicIps(ctx context.Context, req osc.ReadPublicIpsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpsResponse, error) {
+	if err := c.inject(ctx, "ReadPublicIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicIps(ctx, req, opts...)
+	return observe(c, "ReadPublicIps", res, err)
+}
+
+func (c *Client) ReadPublicIpsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpsResponse, error) {
+	if err := c.inject(ctx, "ReadPublicIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadPublicIpsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadPublicIps", res, err)
+}
+
+func (c *Client) ReadQuotas(ctx context.Context, req osc.ReadQuotasRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadQuotasResponse, error) {
+	if err := c.inject(ctx, "ReadQuotas"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadQuotas(ctx, req, opts...)
+	return observe(c, "ReadQuotas", res, err)
+}
+
+func (c *Client) ReadQuotasWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadQuotasResponse, error) {
+	if err := c.inject(ctx, "ReadQuotas"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadQuotasWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadQuotas", res, err)
+}
+
+func (c *Client) ReadRegions(ctx context.Context, req osc.ReadRegionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadRegionsResponse, error) {
+	if err := c.inject(ctx, "ReadRegions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadRegions(ctx, req, opts...)
+	return observe(c, "ReadRegions", res, err)
+}
+
+func (c *Client) ReadRegionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadRegionsResponse, error) {
+	if err := c.inject(ctx, "ReadRegions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadRegionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadRegions", res, err)
+}
+
+func (c *Client) ReadRouteTables(ctx context.Context, req osc.ReadRouteTablesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadRouteTablesResponse, error) {
+	if err := c.inject(ctx, "ReadRouteTables"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadRouteTables(ctx, req, opts...)
+	return observe(c, "ReadRouteTables", res, err)
+}
+
+func (c *Client) ReadRouteTablesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadRouteTablesResponse, error) {
+	if err := c.inject(ctx, "ReadRouteTables"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadRouteTablesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadRouteTables", res, err)
+}
+
+func (c *Client) ReadSecurityGroups(ctx context.Context, req osc.ReadSecurityGroupsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadSecurityGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadSecurityGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSecurityGroups(ctx, req, opts...)
+	return observe(c, "ReadSecurityGroups", res, err)
+}
+
+func (c *Client) ReadSecurityGroupsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadSecurityGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadSecurityGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSecurityGroupsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadSecurityGroups", res, err)
+}
+
+func (c *Client) ReadServerCertificates(ctx context.Context, req osc.ReadServerCertificatesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadServerCertificatesResponse, error) {
+	if err := c.inject(ctx, "ReadServerCertificates"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadServerCertificates(ctx, req, opts...)
+	return observe(c, "ReadServerCertificates", res, err)
+}
+
+func (c *Client) ReadServerCertificatesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadServerCertificatesResponse, error) {
+	if err := c.inject(ctx, "ReadServerCertificates"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadServerCertificatesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadServerCertificates", res, err)
+}
+
+func (c *Client) ReadSnapshotExportTasks(ctx context.Context, req osc.ReadSnapshotExportTasksRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadSnapshotExportTasksResponse, error) {
+	if err := c.inject(ctx, "ReadSnapshotExportTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSnapshotExportTasks(ctx, req, opts...)
+	return observe(c, "ReadSnapshotExportTasks", res, err)
+}
+
+func (c *Client) ReadSnapshotExportTasksWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadSnapshotExportTasksResponse, error) {
+	if err := c.inject(ctx, "ReadSnapshotExportTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSnapshotExportTasksWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadSnapshotExportTasks", res, err)
+}
+
+func (c *Client) ReadSnapshots(ctx context.Context, req osc.ReadSnapshotsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadSnapshotsResponse, error) {
+	if err := c.inject(ctx, "ReadSnapshots"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSnapshots(ctx, req, opts...)
+	return observe(c, "ReadSnapshots", res, err)
+}
+
+func (c *Client) ReadSnapshotsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadSnapshotsResponse, error) {
+	if err := c.inject(ctx, "ReadSnapshots"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSnapshotsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadSnapshots", res, err)
+}
+
+func (c *Client) ReadSubnets(ctx context.Context, req osc.ReadSubnetsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadSubnetsResponse, error) {
+	if err := c.inject(ctx, "ReadSubnets"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSubnets(ctx, req, opts...)
+	return observe(c, "ReadSubnets", res, err)
+}
+
+func (c *Client) ReadSubnetsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadSubnetsResponse, error) {
+	if err := c.inject(ctx, "ReadSubnets"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSubnetsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadSubnets", res, err)
+}
+
+func (c *Client) ReadSubregions(ctx context.Context, req osc.ReadSubregionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadSubregionsResponse, error) {
+	if err := c.inject(ctx, "ReadSubregions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSubregions(ctx, req, opts...)
+	return observe(c, "ReadSubregions", res, err)
+}
+
+func (c *Client) ReadSubregionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadSubregionsResponse, error) {
+	if err := c.inject(ctx, "ReadSubregions"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadSubregionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadSubregions", res, err)
+}
+
+func (c *Client) ReadTags(ctx context.Context, req osc.ReadTagsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadTagsResponse, error) {
+	if err := c.inject(ctx, "ReadTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadTags(ctx, req, opts...)
+	return observe(c, "ReadTags", res, err)
+}
+
+func (c *Client) ReadTagsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadTagsResponse, error) {
+	if err := c.inject(ctx, "ReadTags"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadTagsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadTags", res, err)
+}
+
+func (c *Client) ReadUnitPrice(ctx context.Context, req osc.ReadUnitPriceRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUnitPriceResponse, error) {
+	if err := c.inject(ctx, "ReadUnitPrice"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUnitPrice(ctx, req, opts...)
+	return observe(c, "ReadUnitPrice", res, err)
+}
+
+func (c *Client) ReadUnitPriceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUnitPriceResponse, error) {
+	if err := c.inject(ctx, "ReadUnitPrice"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUnitPriceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUnitPrice", res, err)
+}
+
+func (c *Client) ReadUserGroup(ctx context.Context, req osc.ReadUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroup(ctx, req, opts...)
+	return observe(c, "ReadUserGroup", res, err)
+}
+
+func (c *Client) ReadUserGroupPolicies(ctx context.Context, req osc.ReadUserGroupPoliciesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupPolicies(ctx, req, opts...)
+	return observe(c, "ReadUserGroupPolicies", res, err)
+}
+
+func (c *Client) ReadUserGroupPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupPoliciesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserGroupPolicies", res, err)
+}
+
+func (c *Client) ReadUserGroupPolicy(ctx context.Context, req osc.ReadUserGroupPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupPolicy(ctx, req, opts...)
+	return observe(c, "ReadUserGroupPolicy", res, err)
+}
+
+func (c *Client) ReadUserGroupPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserGroupPolicy", res, err)
+}
+
+func (c *Client) ReadUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserGroup", res, err)
+}
+
+func (c *Client) ReadUserGroups(ctx context.Context, req osc.ReadUserGroupsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroups(ctx, req, opts...)
+	return observe(c, "ReadUserGroups", res, err)
+}
+
+func (c *Client) ReadUserGroupsPerUser(ctx context.Context, req osc.ReadUserGroupsPerUserRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupsPerUserResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupsPerUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupsPerUser(ctx, req, opts...)
+	return observe(c, "ReadUserGroupsPerUser", res, err)
+}
+
+func (c *Client) ReadUserGroupsPerUserWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupsPerUserResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroupsPerUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupsPerUserWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserGroupsPerUser", res, err)
+}
+
+func (c *Client) ReadUserGroupsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadUserGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserGroupsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserGroups", res, err)
+}
+
+func (c *Client) ReadUserPolicies(ctx context.Context, req osc.ReadUserPoliciesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadUserPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserPolicies(ctx, req, opts...)
+	return observe(c, "ReadUserPolicies", res, err)
+}
+
+func (c *Client) ReadUserPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserPoliciesResponse, error) {
+	if err := c.inject(ctx, "ReadUserPolicies"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserPoliciesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserPolicies", res, err)
+}
+
+func (c *Client) ReadUserPolicy(ctx context.Context, req osc.ReadUserPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserPolicy(ctx, req, opts...)
+	return observe(c, "ReadUserPolicy", res, err)
+}
+
+func (c *Client) ReadUserPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUserPolicyResponse, error) {
+	if err := c.inject(ctx, "ReadUserPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUserPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUserPolicy", res, err)
+}
+
+func (c *Client) ReadUsers(ctx context.Context, req osc.ReadUsersRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadUsersResponse, error) {
+	if err := c.inject(ctx, "ReadUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUsers(ctx, req, opts...)
+	return observe(c, "ReadUsers", res, err)
+}
+
+func (c *Client) ReadUsersWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadUsersResponse, error) {
+	if err := c.inject(ctx, "ReadUsers"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadUsersWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadUsers", res, err)
+}
+
+func (c *Client) ReadVirtualGateways(ctx context.Context, req osc.ReadVirtualGatewaysRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVirtualGatewaysResponse, error) {
+	if err := c.inject(ctx, "ReadVirtualGateways"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVirtualGateways(ctx, req, opts...)
+	return observe(c, "ReadVirtualGateways", res, err)
+}
+
+func (c *Client) ReadVirtualGatewaysWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVirtualGatewaysResponse, error) {
+	if err := c.inject(ctx, "ReadVirtualGateways"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVirtualGatewaysWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVirtualGateways", res, err)
+}
+
+func (c *Client) ReadVmGroups(ctx context.Context, req osc.ReadVmGroupsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadVmGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmGroups(ctx, req, opts...)
+	return observe(c, "ReadVmGroups", res, err)
+}
+
+func (c *Client) ReadVmGroupsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmGroupsResponse, error) {
+	if err := c.inject(ctx, "ReadVmGroups"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmGroupsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmGroups", res, err)
+}
+
+func (c *Client) ReadVmTemplates(ctx context.Context, req osc.ReadVmTemplatesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmTemplatesResponse, error) {
+	if err := c.inject(ctx, "ReadVmTemplates"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmTemplates(ctx, req, opts...)
+	return observe(c, "ReadVmTemplates", res, err)
+}
+
+func (c *Client) ReadVmTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmTemplatesResponse, error) {
+	if err := c.inject(ctx, "ReadVmTemplates"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmTemplatesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmTemplates", res, err)
+}
+
+func (c *Client) ReadVmTypes(ctx context.Context, req osc.ReadVmTypesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmTypesResponse, error) {
+	if err := c.inject(ctx, "ReadVmTypes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmTypes(ctx, req, opts...)
+	return observe(c, "ReadVmTypes", res, err)
+}
+
+func (c *Client) ReadVmTypesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmTypesResponse, error) {
+	if err := c.inject(ctx, "ReadVmTypes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmTypesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmTypes", res, err)
+}
+
+func (c *Client) ReadVms(ctx context.Context, req osc.ReadVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsResponse, error) {
+	if err := c.inject(ctx, "ReadVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVms(ctx, req, opts...)
+	return observe(c, "ReadVms", res, err)
+}
+
+func (c *Client) ReadVmsHealth(ctx context.Context, req osc.ReadVmsHealthRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsHealthResponse, error) {
+	if err := c.inject(ctx, "ReadVmsHealth"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsHealth(ctx, req, opts...)
+	return observe(c, "ReadVmsHealth", res, err)
+}
+
+func (c *Client) ReadVmsHealthWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsHealthResponse, error) {
+	if err := c.inject(ctx, "ReadVmsHealth"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsHealthWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmsHealth", res, err)
+}
+
+func (c *Client) ReadVmsState(ctx context.Context, req osc.ReadVmsStateRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsStateResponse, error) {
+	if err := c.inject(ctx, "ReadVmsState"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsState(ctx, req, opts...)
+	return observe(c, "ReadVmsState", res, err)
+}
+
+func (c *Client) ReadVmsStateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsStateResponse, error) {
+	if err := c.inject(ctx, "ReadVmsState"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsStateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmsState", res, err)
+}
+
+func (c *Client) ReadVmsStopHistory(ctx context.Context, req osc.ReadVmsStopHistoryRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsStopHistoryResponse, error) {
+	if err := c.inject(ctx, "ReadVmsStopHistory"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsStopHistory(ctx, req, opts...)
+	return observe(c, "ReadVmsStopHistory", res, err)
+}
+
+func (c *Client) ReadVmsStopHistoryWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsStopHistoryResponse, error) {
+	if err := c.inject(ctx, "ReadVmsStopHistory"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsStopHistoryWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVmsStopHistory", res, err)
+}
+
+func (c *Client) ReadVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVmsResponse, error) {
+	if err := c.inject(ctx, "ReadVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVms", res, err)
+}
+
+func (c *Client) ReadVolumeUpdateTasks(ctx context.Context, req osc.ReadVolumeUpdateTasksRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVolumeUpdateTasksResponse, error) {
+	if err := c.inject(ctx, "ReadVolumeUpdateTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVolumeUpdateTasks(ctx, req, opts...)
+	return observe(c, "ReadVolumeUpdateTasks", res, err)
+}
+
+func (c *Client) ReadVolumeUpdateTasksWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVolumeUpdateTasksResponse, error) {
+	if err := c.inject(ctx, "ReadVolumeUpdateTasks"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVolumeUpdateTasksWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVolumeUpdateTasks", res, err)
+}
+
+func (c *Client) ReadVolumes(ctx context.Context, req osc.ReadVolumesRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVolumesResponse, error) {
+	if err := c.inject(ctx, "ReadVolumes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVolumes(ctx, req, opts...)
+	return observe(c, "ReadVolumes", res, err)
+}
+
+func (c *Client) ReadVolumesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVolumesResponse, error) {
+	if err := c.inject(ctx, "ReadVolumes"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVolumesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVolumes", res, err)
+}
+
+func (c *Client) ReadVpnConnections(ctx context.Context, req osc.ReadVpnConnectionsRequest, opts ...middleware.MiddlewareChainOption) (*osc.ReadVpnConnectionsResponse, error) {
+	if err := c.inject(ctx, "ReadVpnConnections"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVpnConnections(ctx, req, opts...)
+	return observe(c, "ReadVpnConnections", res, err)
+}
+
+func (c *Client) ReadVpnConnectionsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ReadVpnConnectionsResponse, error) {
+	if err := c.inject(ctx, "ReadVpnConnections"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ReadVpnConnectionsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ReadVpnConnections", res, err)
+}
+
+func (c *Client) RebootVms(ctx context.Context, req osc.RebootVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.RebootVmsResponse, error) {
+	if err := c.inject(ctx, "RebootVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RebootVms(ctx, req, opts...)
+	return observe(c, "RebootVms", res, err)
+}
+
+func (c *Client) RebootVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.RebootVmsResponse, error) {
+	if err := c.inject(ctx, "RebootVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RebootVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "RebootVms", res, err)
+}
+
+func (c *Client) RegisterVmsInLoadBalancer(ctx context.Context, req osc.RegisterVmsInLoadBalancerRequest, opts ...middleware.MiddlewareChainOption) (*osc.RegisterVmsInLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "RegisterVmsInLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RegisterVmsInLoadBalancer(ctx, req, opts...)
+	return observe(c, "RegisterVmsInLoadBalancer", res, err)
+}
+
+func (c *Client) RegisterVmsInLoadBalancerWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.RegisterVmsInLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "RegisterVmsInLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RegisterVmsInLoadBalancerWithBody(ctx, contentType, body, opts...)
+	return observe(c, "RegisterVmsInLoadBalancer", res, err)
+}
+
+func (c *Client) RejectNetPeering(ctx context.Context, req osc.RejectNetPeeringRequest, opts ...middleware.MiddlewareChainOption) (*osc.RejectNetPeeringResponse, error) {
+	if err := c.inject(ctx, "RejectNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RejectNetPeering(ctx, req, opts...)
+	return observe(c, "RejectNetPeering", res, err)
+}
+
+func (c *Client) RejectNetPeeringWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.RejectNetPeeringResponse, error) {
+	if err := c.inject(ctx, "RejectNetPeering"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RejectNetPeeringWithBody(ctx, contentType, body, opts...)
+	return observe(c, "RejectNetPeering", res, err)
+}
+
+func (c *Client) RemoveUserFromUserGroup(ctx context.Context, req osc.RemoveUserFromUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.RemoveUserFromUserGroupResponse, error) {
+	if err := c.inject(ctx, "RemoveUserFromUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RemoveUserFromUserGroup(ctx, req, opts...)
+	return observe(c, "RemoveUserFromUserGroup", res, err)
+}
+
+func (c *Client) RemoveUserFromUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.RemoveUserFromUserGroupResponse, error) {
+	if err := c.inject(ctx, "RemoveUserFromUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.RemoveUserFromUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "RemoveUserFromUserGroup", res, err)
+}
+
+func (c *Client) ScaleDownVmGroup(ctx context.Context, req osc.ScaleDownVmGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.ScaleDownVmGroupResponse, error) {
+	if err := c.inject(ctx, "ScaleDownVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ScaleDownVmGroup(ctx, req, opts...)
+	return observe(c, "ScaleDownVmGroup", res, err)
+}
+
+func (c *Client) ScaleDownVmGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ScaleDownVmGroupResponse, error) {
+	if err := c.inject(ctx, "ScaleDownVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ScaleDownVmGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ScaleDownVmGroup", res, err)
+}
+
+func (c *Client) ScaleUpVmGroup(ctx context.Context, req osc.ScaleUpVmGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.ScaleUpVmGroupResponse, error) {
+	if err := c.inject(ctx, "ScaleUpVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ScaleUpVmGroup(ctx, req, opts...)
+	return observe(c, "ScaleUpVmGroup", res, err)
+}
+
+func (c *Client) ScaleUpVmGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.ScaleUpVmGroupResponse, error) {
+	if err := c.inject(ctx, "ScaleUpVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.ScaleUpVmGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "ScaleUpVmGroup", res, err)
+}
+
+func (c *Client) SetDefaultPolicyVersion(ctx context.Context, req osc.SetDefaultPolicyVersionRequest, opts ...middleware.MiddlewareChainOption) (*osc.SetDefaultPolicyVersionResponse, error) {
+	if err := c.inject(ctx, "SetDefaultPolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.SetDefaultPolicyVersion(ctx, req, opts...)
+	return observe(c, "SetDefaultPolicyVersion", res, err)
+}
+
+func (c *Client) SetDefaultPolicyVersionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.SetDefaultPolicyVersionResponse, error) {
+	if err := c.inject(ctx, "SetDefaultPolicyVersion"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.SetDefaultPolicyVersionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "SetDefaultPolicyVersion", res, err)
+}
+
+func (c *Client) StartVms(ctx context.Context, req osc.StartVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.StartVmsResponse, error) {
+	if err := c.inject(ctx, "StartVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.StartVms(ctx, req, opts...)
+	return observe(c, "StartVms", res, err)
+}
+
+func (c *Client) StartVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.StartVmsResponse, error) {
+	if err := c.inject(ctx, "StartVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.StartVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "StartVms", res, err)
+}
+
+func (c *Client) StopVms(ctx context.Context, req osc.StopVmsRequest, opts ...middleware.MiddlewareChainOption) (*osc.StopVmsResponse, error) {
+	if err := c.inject(ctx, "StopVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.StopVms(ctx, req, opts...)
+	return observe(c, "StopVms", res, err)
+}
+
+func (c *Client) StopVmsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.StopVmsResponse, error) {
+	if err := c.inject(ctx, "StopVms"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.StopVmsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "StopVms", res, err)
+}
+
+func (c *Client) UnlinkFlexibleGpu(ctx context.Context, req osc.UnlinkFlexibleGpuRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "UnlinkFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkFlexibleGpu(ctx, req, opts...)
+	return observe(c, "UnlinkFlexibleGpu", res, err)
+}
+
+func (c *Client) UnlinkFlexibleGpuWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "UnlinkFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkFlexibleGpuWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkFlexibleGpu", res, err)
+}
+
+func (c *Client) UnlinkInternetService(ctx context.Context, req osc.UnlinkInternetServiceRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkInternetServiceResponse, error) {
+	if err := c.inject(ctx, "UnlinkInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkInternetService(ctx, req, opts...)
+	return observe(c, "UnlinkInternetService", res, err)
+}
+
+func (c *Client) UnlinkInternetServiceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkInternetServiceResponse, error) {
+	if err := c.inject(ctx, "UnlinkInternetService"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkInternetServiceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkInternetService", res, err)
+}
+
+func (c *Client) UnlinkLoadBalancerBackendMachines(ctx context.Context, req osc.UnlinkLoadBalancerBackendMachinesRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkLoadBalancerBackendMachinesResponse, error) {
+	if err := c.inject(ctx, "UnlinkLoadBalancerBackendMachines"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkLoadBalancerBackendMachines(ctx, req, opts...)
+	return observe(c, "UnlinkLoadBalancerBackendMachines", res, err)
+}
+
+func (c *Client) UnlinkLoadBalancerBackendMachinesWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkLoadBalancerBackendMachinesResponse, error) {
+	if err := c.inject(ctx, "UnlinkLoadBalancerBackendMachines"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkLoadBalancerBackendMachinesWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkLoadBalancerBackendMachines", res, err)
+}
+
+func (c *Client) UnlinkManagedPolicyFromUserGroup(ctx context.Context, req osc.UnlinkManagedPolicyFromUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkManagedPolicyFromUserGroupResponse, error) {
+	if err := c.inject(ctx, "UnlinkManagedPolicyFromUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkManagedPolicyFromUserGroup(ctx, req, opts...)
+	return observe(c, "UnlinkManagedPolicyFromUserGroup", res, err)
+}
+
+func (c *Client) UnlinkManagedPolicyFromUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkManagedPolicyFromUserGroupResponse, error) {
+	if err := c.inject(ctx, "UnlinkManagedPolicyFromUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkManagedPolicyFromUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkManagedPolicyFromUserGroup", res, err)
+}
+
+func (c *Client) UnlinkNic(ctx context.Context, req osc.UnlinkNicRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkNicResponse, error) {
+	if err := c.inject(ctx, "UnlinkNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkNic(ctx, req, opts...)
+	return observe(c, "UnlinkNic", res, err)
+}
+
+func (c *Client) UnlinkNicWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkNicResponse, error) {
+	if err := c.inject(ctx, "UnlinkNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkNicWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkNic", res, err)
+}
+
+func (c *Client) UnlinkPolicy(ctx context.Context, req osc.UnlinkPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPolicyResponse, error) {
+	if err := c.inject(ctx, "UnlinkPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPolicy(ctx, req, opts...)
+	return observe(c, "UnlinkPolicy", res, err)
+}
+
+func (c *Client) UnlinkPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPolicyResponse, error) {
+	if err := c.inject(ctx, "UnlinkPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkPolicy", res, err)
+}
+
+func (c *Client) UnlinkPrivateIps(ctx context.Context, req osc.UnlinkPrivateIpsRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPrivateIpsResponse, error) {
+	if err := c.inject(ctx, "UnlinkPrivateIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPrivateIps(ctx, req, opts...)
+	return observe(c, "UnlinkPrivateIps", res, err)
+}
+
+func (c *Client) UnlinkPrivateIpsWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPrivateIpsResponse, error) {
+	if err := c.inject(ctx, "UnlinkPrivateIps"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPrivateIpsWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkPrivateIps", res, err)
+}
+
+func (c *Client) UnlinkPublicIp(ctx context.Context, req osc.UnlinkPublicIpRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPublicIpResponse, error) {
+	if err := c.inject(ctx, "UnlinkPublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPublicIp(ctx, req, opts...)
+	return observe(c, "UnlinkPublicIp", res, err)
+}
+
+func (c *Client) UnlinkPublicIpWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkPublicIpResponse, error) {
+	if err := c.inject(ctx, "UnlinkPublicIp"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkPublicIpWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkPublicIp", res, err)
+}
+
+func (c *Client) UnlinkRouteTable(ctx context.Context, req osc.UnlinkRouteTableRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkRouteTableResponse, error) {
+	if err := c.inject(ctx, "UnlinkRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkRouteTable(ctx, req, opts...)
+	return observe(c, "UnlinkRouteTable", res, err)
+}
+
+func (c *Client) UnlinkRouteTableWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkRouteTableResponse, error) {
+	if err := c.inject(ctx, "UnlinkRouteTable"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkRouteTableWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkRouteTable", res, err)
+}
+
+func (c *Client) UnlinkVirtualGateway(ctx context.Context, req osc.UnlinkVirtualGatewayRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "UnlinkVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkVirtualGateway(ctx, req, opts...)
+	return observe(c, "UnlinkVirtualGateway", res, err)
+}
+
+func (c *Client) UnlinkVirtualGatewayWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkVirtualGatewayResponse, error) {
+	if err := c.inject(ctx, "UnlinkVirtualGateway"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkVirtualGatewayWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkVirtualGateway", res, err)
+}
+
+func (c *Client) UnlinkVolume(ctx context.Context, req osc.UnlinkVolumeRequest, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkVolumeResponse, error) {
+	if err := c.inject(ctx, "UnlinkVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkVolume(ctx, req, opts...)
+	return observe(c, "UnlinkVolume", res, err)
+}
+
+func (c *Client) UnlinkVolumeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UnlinkVolumeResponse, error) {
+	if err := c.inject(ctx, "UnlinkVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UnlinkVolumeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UnlinkVolume", res, err)
+}
+
+func (c *Client) UpdateAccessKey(ctx context.Context, req osc.UpdateAccessKeyRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateAccessKeyResponse, error) {
+	if err := c.inject(ctx, "UpdateAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateAccessKey(ctx, req, opts...)
+	return observe(c, "UpdateAccessKey", res, err)
+}
+
+func (c *Client) UpdateAccessKeyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateAccessKeyResponse, error) {
+	if err := c.inject(ctx, "UpdateAccessKey"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateAccessKeyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateAccessKey", res, err)
+}
+
+func (c *Client) UpdateAccount(ctx context.Context, req osc.UpdateAccountRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateAccountResponse, error) {
+	if err := c.inject(ctx, "UpdateAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateAccount(ctx, req, opts...)
+	return observe(c, "UpdateAccount", res, err)
+}
+
+func (c *Client) UpdateAccountWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateAccountResponse, error) {
+	if err := c.inject(ctx, "UpdateAccount"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateAccountWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateAccount", res, err)
+}
+
+func (c *Client) UpdateApiAccessPolicy(ctx context.Context, req osc.UpdateApiAccessPolicyRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateApiAccessPolicyResponse, error) {
+	if err := c.inject(ctx, "UpdateApiAccessPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateApiAccessPolicy(ctx, req, opts...)
+	return observe(c, "UpdateApiAccessPolicy", res, err)
+}
+
+func (c *Client) UpdateApiAccessPolicyWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateApiAccessPolicyResponse, error) {
+	if err := c.inject(ctx, "UpdateApiAccessPolicy"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateApiAccessPolicyWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateApiAccessPolicy", res, err)
+}
+
+func (c *Client) UpdateApiAccessRule(ctx context.Context, req osc.UpdateApiAccessRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "UpdateApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateApiAccessRule(ctx, req, opts...)
+	return observe(c, "UpdateApiAccessRule", res, err)
+}
+
+func (c *Client) UpdateApiAccessRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateApiAccessRuleResponse, error) {
+	if err := c.inject(ctx, "UpdateApiAccessRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateApiAccessRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateApiAccessRule", res, err)
+}
+
+func (c *Client) UpdateCa(ctx context.Context, req osc.UpdateCaRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateCaResponse, error) {
+	if err := c.inject(ctx, "UpdateCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateCa(ctx, req, opts...)
+	return observe(c, "UpdateCa", res, err)
+}
+
+func (c *Client) UpdateCaWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateCaResponse, error) {
+	if err := c.inject(ctx, "UpdateCa"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateCaWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateCa", res, err)
+}
+
+func (c *Client) UpdateDedicatedGroup(ctx context.Context, req osc.UpdateDedicatedGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateDedicatedGroup(ctx, req, opts...)
+	return observe(c, "UpdateDedicatedGroup", res, err)
+}
+
+func (c *Client) UpdateDedicatedGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateDedicatedGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateDedicatedGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateDedicatedGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateDedicatedGroup", res, err)
+}
+
+func (c *Client) UpdateDirectLinkInterface(ctx context.Context, req osc.UpdateDirectLinkInterfaceRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "UpdateDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateDirectLinkInterface(ctx, req, opts...)
+	return observe(c, "UpdateDirectLinkInterface", res, err)
+}
+
+func (c *Client) UpdateDirectLinkInterfaceWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateDirectLinkInterfaceResponse, error) {
+	if err := c.inject(ctx, "UpdateDirectLinkInterface"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateDirectLinkInterfaceWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateDirectLinkInterface", res, err)
+}
+
+func (c *Client) UpdateFlexibleGpu(ctx context.Context, req osc.UpdateFlexibleGpuRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "UpdateFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateFlexibleGpu(ctx, req, opts...)
+	return observe(c, "UpdateFlexibleGpu", res, err)
+}
+
+func (c *Client) UpdateFlexibleGpuWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateFlexibleGpuResponse, error) {
+	if err := c.inject(ctx, "UpdateFlexibleGpu"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateFlexibleGpuWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateFlexibleGpu", res, err)
+}
+
+func (c *Client) UpdateImage(ctx context.Context, req osc.UpdateImageRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateImageResponse, error) {
+	if err := c.inject(ctx, "UpdateImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateImage(ctx, req, opts...)
+	return observe(c, "UpdateImage", res, err)
+}
+
+func (c *Client) UpdateImageWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateImageResponse, error) {
+	if err := c.inject(ctx, "UpdateImage"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateImageWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateImage", res, err)
+}
+
+func (c *Client) UpdateListenerRule(ctx context.Context, req osc.UpdateListenerRuleRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateListenerRuleResponse, error) {
+	if err := c.inject(ctx, "UpdateListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateListenerRule(ctx, req, opts...)
+	return observe(c, "UpdateListenerRule", res, err)
+}
+
+func (c *Client) UpdateListenerRuleWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateListenerRuleResponse, error) {
+	if err := c.inject(ctx, "UpdateListenerRule"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateListenerRuleWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateListenerRule", res, err)
+}
+
+func (c *Client) UpdateLoadBalancer(ctx context.Context, req osc.UpdateLoadBalancerRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "UpdateLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateLoadBalancer(ctx, req, opts...)
+	return observe(c, "UpdateLoadBalancer", res, err)
+}
+
+func (c *Client) UpdateLoadBalancerWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateLoadBalancerResponse, error) {
+	if err := c.inject(ctx, "UpdateLoadBalancer"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateLoadBalancerWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateLoadBalancer", res, err)
+}
+
+func (c *Client) UpdateNet(ctx context.Context, req osc.UpdateNetRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNetResponse, error) {
+	if err := c.inject(ctx, "UpdateNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNet(ctx, req, opts...)
+	return observe(c, "UpdateNet", res, err)
+}
+
+func (c *Client) UpdateNetAccessPoint(ctx context.Context, req osc.UpdateNetAccessPointRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "UpdateNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNetAccessPoint(ctx, req, opts...)
+	return observe(c, "UpdateNetAccessPoint", res, err)
+}
+
+func (c *Client) UpdateNetAccessPointWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNetAccessPointResponse, error) {
+	if err := c.inject(ctx, "UpdateNetAccessPoint"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNetAccessPointWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateNetAccessPoint", res, err)
+}
+
+func (c *Client) UpdateNetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNetResponse, error) {
+	if err := c.inject(ctx, "UpdateNet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateNet", res, err)
+}
+
+func (c *Client) UpdateNic(ctx context.Context, req osc.UpdateNicRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNicResponse, error) {
+	if err := c.inject(ctx, "UpdateNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNic(ctx, req, opts...)
+	return observe(c, "UpdateNic", res, err)
+}
+
+func (c *Client) UpdateNicWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateNicResponse, error) {
+	if err := c.inject(ctx, "UpdateNic"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateNicWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateNic", res, err)
+}
+
+func (c *Client) UpdateRoute(ctx context.Context, req osc.UpdateRouteRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRouteResponse, error) {
+	if err := c.inject(ctx, "UpdateRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRoute(ctx, req, opts...)
+	return observe(c, "UpdateRoute", res, err)
+}
+
+func (c *Client) UpdateRoutePropagation(ctx context.Context, req osc.UpdateRoutePropagationRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRoutePropagationResponse, error) {
+	if err := c.inject(ctx, "UpdateRoutePropagation"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRoutePropagation(ctx, req, opts...)
+	return observe(c, "UpdateRoutePropagation", res, err)
+}
+
+func (c *Client) UpdateRoutePropagationWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRoutePropagationResponse, error) {
+	if err := c.inject(ctx, "UpdateRoutePropagation"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRoutePropagationWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateRoutePropagation", res, err)
+}
+
+func (c *Client) UpdateRouteTableLink(ctx context.Context, req osc.UpdateRouteTableLinkRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRouteTableLinkResponse, error) {
+	if err := c.inject(ctx, "UpdateRouteTableLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRouteTableLink(ctx, req, opts...)
+	return observe(c, "UpdateRouteTableLink", res, err)
+}
+
+func (c *Client) UpdateRouteTableLinkWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRouteTableLinkResponse, error) {
+	if err := c.inject(ctx, "UpdateRouteTableLink"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRouteTableLinkWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateRouteTableLink", res, err)
+}
+
+func (c *Client) UpdateRouteWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateRouteResponse, error) {
+	if err := c.inject(ctx, "UpdateRoute"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateRouteWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateRoute", res, err)
+}
+
+func (c *Client) UpdateServerCertificate(ctx context.Context, req osc.UpdateServerCertificateRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateServerCertificateResponse, error) {
+	if err := c.inject(ctx, "UpdateServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateServerCertificate(ctx, req, opts...)
+	return observe(c, "UpdateServerCertificate", res, err)
+}
+
+func (c *Client) UpdateServerCertificateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateServerCertificateResponse, error) {
+	if err := c.inject(ctx, "UpdateServerCertificate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateServerCertificateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateServerCertificate", res, err)
+}
+
+func (c *Client) UpdateSnapshot(ctx context.Context, req osc.UpdateSnapshotRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateSnapshotResponse, error) {
+	if err := c.inject(ctx, "UpdateSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateSnapshot(ctx, req, opts...)
+	return observe(c, "UpdateSnapshot", res, err)
+}
+
+func (c *Client) UpdateSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateSnapshotResponse, error) {
+	if err := c.inject(ctx, "UpdateSnapshot"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateSnapshotWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateSnapshot", res, err)
+}
+
+func (c *Client) UpdateSubnet(ctx context.Context, req osc.UpdateSubnetRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateSubnetResponse, error) {
+	if err := c.inject(ctx, "UpdateSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateSubnet(ctx, req, opts...)
+	return observe(c, "UpdateSubnet", res, err)
+}
+
+func (c *Client) UpdateSubnetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateSubnetResponse, error) {
+	if err := c.inject(ctx, "UpdateSubnet"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateSubnetWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateSubnet", res, err)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, req osc.UpdateUserRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateUserResponse, error) {
+	if err := c.inject(ctx, "UpdateUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateUser(ctx, req, opts...)
+	return observe(c, "UpdateUser", res, err)
+}
+
+func (c *Client) UpdateUserGroup(ctx context.Context, req osc.UpdateUserGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateUserGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateUserGroup(ctx, req, opts...)
+	return observe(c, "UpdateUserGroup", res, err)
+}
+
+func (c *Client) UpdateUserGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateUserGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateUserGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateUserGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateUserGroup", res, err)
+}
+
+func (c *Client) UpdateUserWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateUserResponse, error) {
+	if err := c.inject(ctx, "UpdateUser"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateUserWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateUser", res, err)
+}
+
+func (c *Client) UpdateVm(ctx context.Context, req osc.UpdateVmRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmResponse, error) {
+	if err := c.inject(ctx, "UpdateVm"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVm(ctx, req, opts...)
+	return observe(c, "UpdateVm", res, err)
+}
+
+func (c *Client) UpdateVmGroup(ctx context.Context, req osc.UpdateVmGroupRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVmGroup(ctx, req, opts...)
+	return observe(c, "UpdateVmGroup", res, err)
+}
+
+func (c *Client) UpdateVmGroupWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmGroupResponse, error) {
+	if err := c.inject(ctx, "UpdateVmGroup"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVmGroupWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateVmGroup", res, err)
+}
+
+func (c *Client) UpdateVmTemplate(ctx context.Context, req osc.UpdateVmTemplateRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmTemplateResponse, error) {
+	if err := c.inject(ctx, "UpdateVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVmTemplate(ctx, req, opts...)
+	return observe(c, "UpdateVmTemplate", res, err)
+}
+
+func (c *Client) UpdateVmTemplateWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmTemplateResponse, error) {
+	if err := c.inject(ctx, "UpdateVmTemplate"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVmTemplateWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateVmTemplate", res, err)
+}
+
+func (c *Client) UpdateVmWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVmResponse, error) {
+	if err := c.inject(ctx, "UpdateVm"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVmWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateVm", res, err)
+}
+
+func (c *Client) UpdateVolume(ctx context.Context, req osc.UpdateVolumeRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVolumeResponse, error) {
+	if err := c.inject(ctx, "UpdateVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVolume(ctx, req, opts...)
+	return observe(c, "UpdateVolume", res, err)
+}
+
+func (c *Client) UpdateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVolumeResponse, error) {
+	if err := c.inject(ctx, "UpdateVolume"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVolumeWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateVolume", res, err)
+}
+
+func (c *Client) UpdateVpnConnection(ctx context.Context, req osc.UpdateVpnConnectionRequest, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "UpdateVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVpnConnection(ctx, req, opts...)
+	return observe(c, "UpdateVpnConnection", res, err)
+}
+
+func (c *Client) UpdateVpnConnectionWithBody(ctx context.Context, contentType string, body io.Reader, opts ...middleware.MiddlewareChainOption) (*osc.UpdateVpnConnectionResponse, error) {
+	if err := c.inject(ctx, "UpdateVpnConnection"); err != nil {
+		return nil, err
+	}
+	res, err := c.client.UpdateVpnConnectionWithBody(ctx, contentType, body, opts...)
+	return observe(c, "UpdateVpnConnection", res, err)
+}
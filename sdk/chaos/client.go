@@ -0,0 +1,298 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package chaos provides a fault-injecting decorator of osc.ClientInterface, for chaos testing.
+//
+// Faults are injected per call name (e.g. ReadVms): errors, latency, throttling and timeouts.
+// The eventual consistency of the API may also be simulated: resources returned by a Create call are missing from,
+// or stale in, the responses of the next reads.
+// Probabilistic faults are drawn from a seeded generator, making runs reproducible.
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+//go:generate go run ./internal/gen
+
+// Fault is a fault injected in calls.
+// Faults without error, throttling or timeout only add latency.
+type Fault struct {
+	// Err is returned instead of calling the API.
+	Err error
+	// Throttle returns a throttling error instead of calling the API, classified as sdkerrors.ErrThrottled.
+	Throttle bool
+	// Timeout makes calls hang during Timeout, or until their context is done, and fail with the context error
+	// (context.DeadlineExceeded on timeout).
+	Timeout time.Duration
+	// Latency is added before calling the API, or before returning the fault.
+	Latency time.Duration
+	// Rate is the probability of a call being affected by the fault, all calls being affected if 0.
+	Rate float64
+	// Count is the number of calls affected by the fault, 0 meaning all calls.
+	Count int
+}
+
+// Consistency is the eventual consistency of resources returned by a Create call.
+type Consistency struct {
+	// Reads is the number of reads that would return a created resource, before the resource is returned as is.
+	Reads int
+	// Stale returns the resource as returned by the Create call (e.g. a creating volume) during those reads,
+	// instead of omitting it.
+	Stale bool
+}
+
+type fault struct {
+	Fault
+	call string
+}
+
+// pending is a created resource, not yet consistent.
+type pending struct {
+	reads    int
+	stale    bool
+	snapshot []byte
+}
+
+// Client is a fault-injecting decorator of osc.ClientInterface.
+type Client struct {
+	client osc.ClientInterface
+
+	mu          sync.Mutex
+	rand        *rand.Rand
+	faults      []*fault
+	consistency map[string]Consistency
+	pending     map[string]*pending
+}
+
+var _ osc.ClientInterface = (*Client)(nil)
+
+// NewClient returns a client injecting faults in the calls to client, probabilistic faults being drawn from seed.
+func NewClient(client osc.ClientInterface, seed uint64) *Client {
+	return &Client{
+		client:      client,
+		rand:        rand.New(rand.NewPCG(seed, seed)), //nolint:gosec
+		consistency: map[string]Consistency{},
+		pending:     map[string]*pending{},
+	}
+}
+
+// Inject injects a fault in a call, or in all calls if call is empty.
+// Faults are applied in injection order, the first drawn fault being applied, until their count is reached.
+func (c *Client) Inject(call string, f Fault) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults = append(c.faults, &fault{Fault: f, call: call})
+}
+
+// Throttle throttles the next count calls.
+func (c *Client) Throttle(call string, count int) {
+	c.Inject(call, Fault{Throttle: true, Count: count})
+}
+
+// SetConsistency sets the consistency of the resources created by a Create call (e.g. CreateVolume),
+// or by all Create calls if call is empty.
+func (c *Client) SetConsistency(call string, cons Consistency) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.consistency[call] = cons
+}
+
+// Reset removes all faults, and makes all created resources consistent.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults = nil
+	clear(c.consistency)
+	clear(c.pending)
+}
+
+// APIError returns an error, formatted like the errors of the OAPI client, and classified by sdk/errors.
+func APIError(status int, errType, details string) error {
+	return fmt.Errorf("HTTP %d: %w", status, &osc.ErrorResponse{
+		Errors:          []osc.Errors{{Type: errType, Details: details}},
+		ResponseContext: &osc.ResponseContext{RequestId: ptr.To("chaos")},
+	})
+}
+
+// fault returns the fault to apply to a call, if any.
+func (c *Client) fault(call string) (Fault, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, f := range c.faults {
+		if f.call != "" && f.call != call {
+			continue
+		}
+		if f.Rate > 0 && c.rand.Float64() >= f.Rate {
+			continue
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				c.faults = slices.Delete(c.faults, i, i+1)
+			}
+		}
+		return f.Fault, true
+	}
+	return Fault{}, false
+}
+
+// inject applies the fault of a call, if any.
+func (c *Client) inject(ctx context.Context, call string) error {
+	f, found := c.fault(call)
+	if !found {
+		return nil
+	}
+	if err := sleep(ctx, f.Latency); err != nil {
+		return fmt.Errorf("%s: %w", call, err)
+	}
+	switch {
+	case f.Timeout > 0:
+		ctx, cancel := context.WithTimeout(ctx, f.Timeout)
+		defer cancel()
+		<-ctx.Done()
+		return fmt.Errorf("%s: %w", call, ctx.Err())
+	case f.Throttle:
+		return APIError(http.StatusServiceUnavailable, "RequestLimitExceeded", "request limit exceeded")
+	default:
+		return f.Err
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// observe tracks the resources returned by Create calls, and makes Read calls eventually consistent.
+// Read responses are copied before being altered, leaving the response of the wrapped client untouched.
+func observe[T any](c *Client, call string, res *T, err error) (*T, error) {
+	if err != nil || res == nil {
+		return res, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case strings.HasPrefix(call, "Read"):
+		cp := *res
+		c.lag(reflect.ValueOf(&cp).Elem())
+		return &cp, nil
+	case strings.HasPrefix(call, "Create"):
+		cons, found := c.consistency[call]
+		if !found {
+			cons = c.consistency[""]
+		}
+		if cons.Reads > 0 {
+			c.track(reflect.ValueOf(res).Elem(), cons)
+		}
+	}
+	return res, nil
+}
+
+// track tracks the resources of a Create response.
+func (c *Client) track(res reflect.Value, cons Consistency) {
+	for i := range res.NumField() {
+		f := res.Field(i)
+		if f.Kind() != reflect.Pointer || f.IsNil() {
+			continue
+		}
+		f = f.Elem()
+		switch f.Kind() {
+		case reflect.Struct:
+			c.trackResource(f, cons)
+		case reflect.Slice:
+			for j := range f.Len() {
+				c.trackResource(f.Index(j), cons)
+			}
+		}
+	}
+}
+
+func (c *Client) trackResource(res reflect.Value, cons Consistency) {
+	key, found := keyOf(res)
+	if !found {
+		return
+	}
+	snapshot, err := json.Marshal(res.Interface())
+	if err != nil {
+		return
+	}
+	c.pending[key] = &pending{reads: cons.Reads, stale: cons.Stale, snapshot: snapshot}
+}
+
+// lag removes, or replaces by their snapshot, the pending resources of a Read response.
+// Lists are replaced by new lists, and are not altered.
+func (c *Client) lag(res reflect.Value) {
+	for i := range res.NumField() {
+		f := res.Field(i)
+		if f.Kind() != reflect.Pointer || f.IsNil() || f.Elem().Kind() != reflect.Slice {
+			continue
+		}
+		items := f.Elem()
+		lagged := reflect.MakeSlice(items.Type(), 0, items.Len())
+		for j := range items.Len() {
+			item := items.Index(j)
+			key, found := keyOf(item)
+			p := c.pending[key]
+			if !found || p == nil {
+				lagged = reflect.Append(lagged, item)
+				continue
+			}
+			p.reads--
+			if p.reads <= 0 {
+				delete(c.pending, key)
+			}
+			if !p.stale {
+				continue
+			}
+			stale := reflect.New(item.Type())
+			if err := json.Unmarshal(p.snapshot, stale.Interface()); err != nil {
+				lagged = reflect.Append(lagged, item)
+				continue
+			}
+			lagged = reflect.Append(lagged, stale.Elem())
+		}
+		list := reflect.New(items.Type())
+		list.Elem().Set(lagged)
+		f.Set(list)
+	}
+}
+
+// keyOf returns the key of a resource, built from its type and its ID (e.g. Volume.VolumeId), or its name
+// (e.g. LoadBalancer.LoadBalancerName).
+func keyOf(res reflect.Value) (string, bool) {
+	if res.Kind() != reflect.Struct {
+		return "", false
+	}
+	name := res.Type().Name()
+	for _, field := range []string{name + "Id", name + "Name"} {
+		if id := res.FieldByName(field); id.IsValid() && id.Kind() == reflect.String && id.String() != "" {
+			return name + "/" + id.String(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,142 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package chaos_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/sdk/chaos"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func readVolume(t *testing.T, c osc.ClientInterface, id string) []osc.Volume {
+	t.Helper()
+	res, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{VolumeIds: &[]string{id}}})
+	require.NoError(t, err)
+	return ptr.From(res.Volumes)
+}
+
+func TestClient_Faults(t *testing.T) {
+	t.Run("Throttled calls return throttling errors", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.Throttle("ReadVolumes", 2)
+		for range 2 {
+			_, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+			assert.True(t, sdkerrors.Is(err, sdkerrors.ErrThrottled))
+		}
+		_, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+	})
+	t.Run("Faults only affect their call", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.Inject("ReadVms", chaos.Fault{Err: chaos.APIError(401, "AccessDenied", "denied")})
+		_, err := c.ReadVms(t.Context(), osc.ReadVmsRequest{})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrUnauthorized))
+		_, err = c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		_, err = c.ReadVms(t.Context(), osc.ReadVmsRequest{})
+		require.Error(t, err, "faults without count affect all calls")
+		c.Reset()
+		_, err = c.ReadVms(t.Context(), osc.ReadVmsRequest{})
+		require.NoError(t, err)
+	})
+	t.Run("Timeouts return a context error", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.Inject("", chaos.Fault{Timeout: 10 * time.Millisecond, Count: 1})
+		_, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		c.Inject("", chaos.Fault{Timeout: time.Hour, Count: 1})
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = c.ReadVolumes(ctx, osc.ReadVolumesRequest{})
+		require.ErrorIs(t, err, context.DeadlineExceeded, "calls stop hanging when their context is done")
+	})
+	t.Run("Latency is added to calls", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.Inject("ReadVolumes", chaos.Fault{Latency: 20 * time.Millisecond})
+		start := time.Now()
+		_, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+	t.Run("Probabilistic faults are deterministic", func(t *testing.T) {
+		failures := func(seed uint64) []bool {
+			c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), seed)
+			c.Inject("ReadVolumes", chaos.Fault{Err: errors.New("boom"), Rate: 0.5})
+			var res []bool
+			for range 50 {
+				_, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+				res = append(res, err != nil)
+			}
+			return res
+		}
+		res := failures(42)
+		assert.Equal(t, res, failures(42))
+		assert.Contains(t, res, true)
+		assert.Contains(t, res, false)
+	})
+}
+
+func TestClient_Consistency(t *testing.T) {
+	t.Run("Created resources are not found during the first reads", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.SetConsistency("CreateVolume", chaos.Consistency{Reads: 2})
+		res, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{Size: ptr.To(10), SubregionName: "eu-west-2a"})
+		require.NoError(t, err)
+		id := res.Volume.VolumeId
+		assert.Empty(t, readVolume(t, c, id))
+		assert.Empty(t, readVolume(t, c, id))
+		assert.Len(t, readVolume(t, c, id), 1)
+	})
+	t.Run("Created resources are stale during the first reads", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{TransientReads: 1}), 1)
+		c.SetConsistency("", chaos.Consistency{Reads: 1, Stale: true})
+		res, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{Size: ptr.To(10), SubregionName: "eu-west-2a"})
+		require.NoError(t, err)
+		id := res.Volume.VolumeId
+		vols := readVolume(t, c, id)
+		require.Len(t, vols, 1)
+		assert.Equal(t, *res.Volume, vols[0])
+		assert.Equal(t, osc.VolumeStateCreating, vols[0].State)
+		vols = readVolume(t, c, id)
+		require.Len(t, vols, 1)
+		assert.Equal(t, osc.VolumeStateAvailable, vols[0].State)
+	})
+	t.Run("Responses of the wrapped client are not altered", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		vol := osc.Volume{VolumeId: "vol-foo"}
+		mockSDK.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).Return(&osc.CreateVolumeResponse{Volume: &vol}, nil)
+		canned := &osc.ReadVolumesResponse{Volumes: &[]osc.Volume{vol}}
+		mockSDK.EXPECT().ReadVolumes(gomock.Any(), gomock.Any()).Return(canned, nil).Times(2)
+
+		c := chaos.NewClient(mockSDK, 1)
+		c.SetConsistency("", chaos.Consistency{Reads: 1})
+		_, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, readVolume(t, c, "vol-foo"))
+		assert.Equal(t, []osc.Volume{vol}, *canned.Volumes)
+		assert.Len(t, readVolume(t, c, "vol-foo"), 1)
+	})
+	t.Run("Resources created by other calls are consistent", func(t *testing.T) {
+		c := chaos.NewClient(fake_osc.NewClient(fake_osc.Options{}), 1)
+		c.SetConsistency("CreateSnapshot", chaos.Consistency{Reads: 2})
+		res, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{Size: ptr.To(10), SubregionName: "eu-west-2a"})
+		require.NoError(t, err)
+		assert.Len(t, readVolume(t, c, res.Volume.VolumeId), 1)
+	})
+}
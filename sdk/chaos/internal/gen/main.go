@@ -0,0 +1,81 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Command gen generates the methods of chaos.Client, wrapping all the methods of osc.ClientInterface.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+const header = `// Code generated by go run ./internal/gen. DO NOT EDIT.
+
+package chaos
+
+import (
+	"context"
+	"io"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+`
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	typ := reflect.TypeFor[osc.ClientInterface]()
+	for i := range typ.NumMethod() {
+		m := typ.Method(i)
+		call := strings.TrimSuffix(m.Name, "WithBody")
+		var params, args []string
+		for j := range m.Type.NumIn() {
+			in := m.Type.In(j)
+			name := paramName(j, in)
+			if m.Type.IsVariadic() && j == m.Type.NumIn()-1 {
+				params = append(params, name+" ..."+in.Elem().String())
+				args = append(args, name+"...")
+				continue
+			}
+			params = append(params, name+" "+in.String())
+			args = append(args, name)
+		}
+		fmt.Fprintf(&buf, "\nfunc (c *Client) %s(%s) (%s, error) {\n", m.Name, strings.Join(params, ", "), m.Type.Out(0))
+		fmt.Fprintf(&buf, "\tif err := c.inject(ctx, %q); err != nil {\n\t\treturn nil, err\n\t}\n", call)
+		fmt.Fprintf(&buf, "\tres, err := c.client.%s(%s)\n", m.Name, strings.Join(args, ", "))
+		fmt.Fprintf(&buf, "\treturn observe(c, %q, res, err)\n}\n", call)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("client.gen.go", src, 0o644); err != nil { //nolint:gosec
+		log.Fatal(err)
+	}
+}
+
+func paramName(i int, t reflect.Type) string {
+	switch {
+	case i == 0:
+		return "ctx"
+	case t.Kind() == reflect.Slice:
+		return "opts"
+	case t.Kind() == reflect.String:
+		return "contentType"
+	case t.Kind() == reflect.Interface:
+		return "body"
+	default:
+		return "req"
+	}
+}
@@ -0,0 +1,142 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package errors classifies OAPI errors into sentinel errors, usable with errors.Is and errors.As.
+package errors
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// kind is a sentinel error, optionally part of a parent kind.
+type kind struct {
+	msg    string
+	parent error
+}
+
+func (k *kind) Error() string { return k.msg }
+func (k *kind) Unwrap() error { return k.parent }
+
+// New returns a sentinel error. If parent is not nil, errors.Is(err, parent) is true.
+func New(msg string, parent error) error {
+	return &kind{msg: msg, parent: parent}
+}
+
+var (
+	// ErrNotFound is returned when a resource does not exist.
+	ErrNotFound = New("not found", nil)
+	// ErrConflict is returned when a resource is not in a state allowing the call.
+	ErrConflict = New("conflict", nil)
+	// ErrInUse is returned when a resource is used by another resource.
+	ErrInUse = New("in use", ErrConflict)
+	// ErrDependencyViolation is returned when a resource has dependencies preventing the call.
+	ErrDependencyViolation = New("dependency violation", ErrConflict)
+	// ErrQuotaExceeded is returned when a quota is exceeded or when capacity is exhausted.
+	ErrQuotaExceeded = New("quota exceeded", nil)
+	// ErrThrottled is returned when calls are throttled.
+	ErrThrottled = New("throttled", nil)
+	// ErrInvalidParameter is returned when a parameter is invalid or missing.
+	ErrInvalidParameter = New("invalid parameter", nil)
+	// ErrUnauthorized is returned on authentication and authorization errors.
+	ErrUnauthorized = New("unauthorized", nil)
+)
+
+// Error is a classified OAPI error.
+type Error struct {
+	// Kind is the sentinel error of the error, nil if the error is not classified.
+	Kind error
+	// HTTPStatus is the HTTP status of the response, 0 if unknown.
+	HTTPStatus int
+	// Code, Type and Details are the first OAPI error of the response.
+	Code, Type, Details string
+	// RequestID is the OAPI request ID.
+	RequestID string
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.err}
+	}
+	return []error{e.Kind, e.err}
+}
+
+var (
+	// throttledTypes are the OAPI error types of throttled calls.
+	throttledTypes = []string{"RequestLimitExceeded", "TooManyRequests", "Throttling"}
+	// statusRe matches the HTTP status in errors returned by the OAPI client.
+	statusRe = regexp.MustCompile(`^(?:HTTP |unexpected response status )(\d{3})\b`)
+)
+
+// Wrap classifies an error returned by the OAPI client. It returns nil for nil errors, and errors already
+// wrapped unchanged. Other errors (network errors, context errors, ...) are wrapped without kind.
+func Wrap(err error) error {
+	var e *Error
+	if err == nil || errors.As(err, &e) {
+		return err
+	}
+	e = &Error{err: err}
+	if oerr := osc.AsErrorResponse(err); oerr != nil {
+		if len(oerr.Errors) > 0 {
+			e.Code, e.Type, e.Details = oerr.Errors[0].Code, oerr.Errors[0].Type, oerr.Errors[0].Details
+		}
+		if oerr.ResponseContext != nil && oerr.ResponseContext.RequestId != nil {
+			e.RequestID = *oerr.ResponseContext.RequestId
+		}
+	}
+	for cur := err; cur != nil; cur = errors.Unwrap(cur) {
+		if m := statusRe.FindStringSubmatch(cur.Error()); m != nil {
+			e.HTTPStatus, _ = strconv.Atoi(m[1])
+			break
+		}
+	}
+	e.Kind = classify(err, e)
+	return e
+}
+
+// Is wraps err, and reports whether it matches target.
+func Is(err, target error) bool {
+	return errors.Is(Wrap(err), target)
+}
+
+func classify(err error, e *Error) error {
+	switch {
+	case osc.IsAuthError(err) || e.HTTPStatus == 401 || e.HTTPStatus == 403:
+		return ErrUnauthorized
+	case osc.IsQuotaOrCapacity(err):
+		return ErrQuotaExceeded
+	case slices.Contains(throttledTypes, e.Type) || e.HTTPStatus == 429 || e.HTTPStatus == 503:
+		return ErrThrottled
+	case osc.IsNotFound(err):
+		return ErrNotFound
+	case strings.Contains(e.Type, "DependencyViolation"):
+		return ErrDependencyViolation
+	case strings.Contains(e.Type, "InUse"):
+		return ErrInUse
+	case osc.IsConflict(err):
+		return ErrConflict
+	case strings.HasPrefix(e.Type, "InvalidParameter") || strings.HasPrefix(e.Type, "MissingParameter") || codeIn(e.Code, 4000, 4999):
+		return ErrInvalidParameter
+	default:
+		return nil
+	}
+}
+
+func codeIn(code string, low, high int) bool {
+	c, err := strconv.Atoi(code)
+	return err == nil && c >= low && c <= high
+}
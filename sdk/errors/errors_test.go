@@ -0,0 +1,74 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package errors_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func oapiError(status int, code, typ string) error {
+	return fmt.Errorf("HTTP %d: %w", status, &osc.ErrorResponse{
+		Errors:          []osc.Errors{{Code: code, Type: typ, Details: "details"}},
+		ResponseContext: &osc.ResponseContext{RequestId: ptr.To("req-foo")},
+	})
+}
+
+func TestWrap(t *testing.T) {
+	t.Run("OAPI errors are classified", func(t *testing.T) {
+		for _, tc := range []struct {
+			err  error
+			kind error
+		}{
+			{err: oapiError(401, "1", "AccessDenied"), kind: sdkerrors.ErrUnauthorized},
+			{err: oapiError(400, "10001", "TooManyResources (QuotaExceded)"), kind: sdkerrors.ErrQuotaExceeded},
+			{err: errors.New("unexpected response status 503 Service Unavailable: "), kind: sdkerrors.ErrThrottled},
+			{err: oapiError(400, "5064", "InvalidResource"), kind: sdkerrors.ErrNotFound},
+			{err: oapiError(409, "9029", "ResourceInUse"), kind: sdkerrors.ErrInUse},
+			{err: oapiError(409, "9044", "DependencyViolation"), kind: sdkerrors.ErrDependencyViolation},
+			{err: oapiError(409, "6031", "InvalidState"), kind: sdkerrors.ErrConflict},
+			{err: oapiError(400, "4045", "InvalidParameterValue"), kind: sdkerrors.ErrInvalidParameter},
+		} {
+			err := sdkerrors.Wrap(tc.err)
+			assert.ErrorIs(t, err, tc.kind, tc.err.Error())
+			assert.Equal(t, tc.err.Error(), err.Error())
+		}
+	})
+	t.Run("Kinds are part of their parent kinds", func(t *testing.T) {
+		err := sdkerrors.Wrap(oapiError(409, "9029", "ResourceInUse"))
+		assert.ErrorIs(t, err, sdkerrors.ErrConflict)
+		assert.NotErrorIs(t, err, sdkerrors.ErrDependencyViolation)
+	})
+	t.Run("The code, request ID and HTTP status are kept", func(t *testing.T) {
+		err := fmt.Errorf("create volume: %w", sdkerrors.Wrap(oapiError(400, "4045", "InvalidParameterValue")))
+		var oerr *sdkerrors.Error
+		require.ErrorAs(t, err, &oerr)
+		assert.Equal(t, 400, oerr.HTTPStatus)
+		assert.Equal(t, "4045", oerr.Code)
+		assert.Equal(t, "InvalidParameterValue", oerr.Type)
+		assert.Equal(t, "details", oerr.Details)
+		assert.Equal(t, "req-foo", oerr.RequestID)
+		assert.NotNil(t, osc.AsErrorResponse(err), "the OAPI error is still available")
+	})
+	t.Run("Other errors are not classified", func(t *testing.T) {
+		assert.NoError(t, sdkerrors.Wrap(nil))
+		err := sdkerrors.Wrap(context.DeadlineExceeded)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, sdkerrors.Is(err, sdkerrors.ErrNotFound))
+	})
+	t.Run("Wrapped errors are not wrapped again", func(t *testing.T) {
+		err := sdkerrors.Wrap(oapiError(400, "5064", "InvalidResource"))
+		assert.Same(t, err, sdkerrors.Wrap(err))
+	})
+}
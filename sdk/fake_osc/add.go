@@ -0,0 +1,73 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// Add adds existing resources to the fake, in their current state, e.g. resources built by sdk/fixtures.
+// Supported types are osc.Net, osc.Subnet, osc.SecurityGroup, osc.Vm, osc.Volume, osc.Snapshot, osc.PublicIp and
+// osc.LoadBalancer, and pointers to them. Links between resources are not checked.
+func (c *Client) Add(resources ...any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, res := range resources {
+		if v := reflect.ValueOf(res); v.Kind() == reflect.Pointer && !v.IsNil() {
+			res = v.Elem().Interface()
+		}
+		var err error
+		switch res := res.(type) {
+		case osc.Net:
+			err = add(c, &c.nets, res.NetId, osc.TagResourceTypeNet, res, res.Tags)
+		case osc.Subnet:
+			err = add(c, &c.subnets, res.SubnetId, osc.TagResourceTypeSubnet, res, res.Tags)
+		case osc.SecurityGroup:
+			err = add(c, &c.sgs, res.SecurityGroupId, osc.TagResourceTypeSecurityGroup, res, res.Tags)
+		case osc.Vm:
+			err = add(c, &c.vms, res.VmId, osc.TagResourceTypeVm, res, res.Tags)
+		case osc.Volume:
+			err = add(c, &c.volumes, res.VolumeId, osc.TagResourceTypeVolume, res, res.Tags)
+		case osc.Snapshot:
+			err = add(c, &c.snapshots, res.SnapshotId, osc.TagResourceTypeSnapshot, res, ptr.From(res.Tags))
+		case osc.PublicIp:
+			err = add(c, &c.publicIps, res.PublicIpId, osc.TagResourceTypePublicIp, res, res.Tags)
+		case osc.LoadBalancer:
+			// load balancer tags are stored with load balancers.
+			if _, found := c.lbs.get(res.LoadBalancerName); found {
+				err = c.fail(errConflict, "LoadBalancer %s already exists", res.LoadBalancerName)
+			} else {
+				c.lbs.add(res.LoadBalancerName, clone(res))
+			}
+		default:
+			err = fmt.Errorf("add %T: unsupported resource type", res)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func add[T any](c *Client, s *store[T], id string, typ osc.TagResourceType, res T, tags []osc.ResourceTag) error {
+	if id == "" {
+		return c.fail(errMissingParameter, "%T has no ID", res)
+	}
+	if _, found := c.types[id]; found {
+		return c.fail(errConflict, "resource %s already exists", id)
+	}
+	s.add(id, clone(res))
+	c.register(id, typ)
+	if len(tags) > 0 {
+		c.tags[id] = slices.Clone(tags)
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package fake_osc provides a stateful in-memory fake of the OAPI, implementing osc.ClientInterface.
+//
+// The fake stores Nets, Subnets, security groups, VMs, volumes, snapshots, public IPs, load balancers and tags.
+// It implements the Create/Delete/Read calls of those resources, LinkVolume/UnlinkVolume,
+// LinkPublicIp/UnlinkPublicIp, and the tag calls (CreateTags/DeleteTags/ReadTags and their load balancer variants).
+// Other calls panic with the name of the call.
+//
+// Read calls apply the most common filters (IDs, states, Net/Subnet/subregion and tags) and paginate results.
+// Resources go through transient states (e.g. a volume is creating, then available) during a configurable number of reads.
+// Errors have the format of the OAPI client, and are classified by sdk/errors.
+// Existing resources, e.g. built with sdk/fixtures, may be added with Add.
+package fake_osc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"go.uber.org/mock/gomock"
+)
+
+// DefaultSubregion is the subregion of resources created without subregion.
+const DefaultSubregion = "eu-west-2a"
+
+// maxResultsPerPage is the max value of ResultsPerPage.
+const maxResultsPerPage = 1000
+
+// Options configures the fake.
+type Options struct {
+	// TransientReads is the number of reads returning a resource in a transient state (e.g. a creating volume),
+	// before it reaches its final state (e.g. available). Transitions are immediate if 0.
+	TransientReads int
+	// Subregion is the subregion of resources created without subregion. Defaults to DefaultSubregion.
+	Subregion string
+}
+
+// Client is a stateful in-memory fake of the OAPI.
+// Calls not implemented by the fake panic with the name of the call.
+type Client struct {
+	// ClientInterface handles the calls not implemented by the fake.
+	osc.ClientInterface
+
+	opts Options
+
+	mu        sync.Mutex
+	seq       int
+	nets      store[osc.Net]
+	subnets   store[osc.Subnet]
+	sgs       store[osc.SecurityGroup]
+	vms       store[osc.Vm]
+	volumes   store[osc.Volume]
+	snapshots store[osc.Snapshot]
+	publicIps store[osc.PublicIp]
+	lbs       store[osc.LoadBalancer]
+	tags      map[string][]osc.ResourceTag
+	types     map[string]osc.TagResourceType
+}
+
+var _ osc.ClientInterface = (*Client)(nil)
+
+// NewClient returns an empty fake.
+func NewClient(opts Options) *Client {
+	if opts.Subregion == "" {
+		opts.Subregion = DefaultSubregion
+	}
+	return &Client{
+		ClientInterface: mocks_osc.NewMockClient(gomock.NewController(notImplemented{})),
+		opts:            opts,
+		nets:            newStore[osc.Net](),
+		subnets:         newStore[osc.Subnet](),
+		sgs:             newStore[osc.SecurityGroup](),
+		vms:             newStore[osc.Vm](),
+		volumes:         newStore[osc.Volume](),
+		snapshots:       newStore[osc.Snapshot](),
+		publicIps:       newStore[osc.PublicIp](),
+		lbs:             newStore[osc.LoadBalancer](),
+		tags:            map[string][]osc.ResourceTag{},
+		types:           map[string]osc.TagResourceType{},
+	}
+}
+
+// notImplemented reports the calls not implemented by the fake, which have no gomock expectation.
+type notImplemented struct{}
+
+func (notImplemented) Errorf(format string, args ...any) {
+	notImplemented{}.Fatalf(format, args...)
+}
+
+func (notImplemented) Fatalf(format string, args ...any) {
+	// unexpected calls are reported with the receiver and the method as first arguments.
+	if len(args) > 1 {
+		panic(fmt.Sprintf("fake_osc: %v is not implemented", args[1]))
+	}
+	panic("fake_osc: " + fmt.Sprintf(format, args...))
+}
+
+// next returns the next sequence number.
+func (c *Client) next() int {
+	c.seq++
+	return c.seq
+}
+
+// newID returns a new resource ID, not used by resources added with Add.
+func (c *Client) newID(prefix string) string {
+	for {
+		id := fmt.Sprintf("%s-%08x", prefix, c.next())
+		if _, found := c.types[id]; !found {
+			return id
+		}
+	}
+}
+
+// register registers a taggable resource.
+func (c *Client) register(id string, typ osc.TagResourceType) {
+	c.types[id] = typ
+}
+
+// unregister removes the tags of a deleted resource.
+func (c *Client) unregister(id string) {
+	delete(c.types, id)
+	delete(c.tags, id)
+}
+
+// tagsOf returns a copy of the tags of a resource.
+func (c *Client) tagsOf(id string) []osc.ResourceTag {
+	return append([]osc.ResourceTag{}, c.tags[id]...)
+}
+
+func (c *Client) responseContext() *osc.ResponseContext {
+	return &osc.ResponseContext{RequestId: ptr.To(fmt.Sprintf("req-%08x", c.next()))}
+}
+
+// oapiError is an error returned by the API.
+type oapiError struct {
+	status    int
+	code, typ string
+}
+
+var (
+	errNotFound            = oapiError{status: 400, code: "5064", typ: "InvalidResource"}
+	errInvalidParameter    = oapiError{status: 400, code: "4045", typ: "InvalidParameterValue"}
+	errMissingParameter    = oapiError{status: 400, code: "7000", typ: "MissingParameter"}
+	errInvalidState        = oapiError{status: 409, code: "6031", typ: "InvalidState"}
+	errConflict            = oapiError{status: 409, code: "9008", typ: "ResourceConflict"}
+	errInUse               = oapiError{status: 409, code: "9029", typ: "ResourceInUse"}
+	errDependencyViolation = oapiError{status: 409, code: "9044", typ: "DependencyViolation"}
+	errQuotaExceeded       = oapiError{status: 400, code: "10001", typ: "TooManyResources (QuotaExceded)"}
+)
+
+// fail returns an error, formatted like the errors of the OAPI client.
+func (c *Client) fail(e oapiError, format string, args ...any) error {
+	return fmt.Errorf("HTTP %d: %w", e.status, &osc.ErrorResponse{
+		Errors:          []osc.Errors{{Code: e.code, Type: e.typ, Details: fmt.Sprintf(format, args...)}},
+		ResponseContext: c.responseContext(),
+	})
+}
+
+func (c *Client) notFound(kind, id string) error {
+	return c.fail(errNotFound, "the %s %q does not exist", kind, id)
+}
+
+// item is a stored resource, with its pending lifecycle transition.
+type item[T any] struct {
+	res T
+	// reads is the number of reads before next is applied.
+	reads int
+	// next applies a transition, and returns false if the resource is deleted.
+	next func(res *T) bool
+}
+
+// store stores resources of a type, in creation order.
+type store[T any] struct {
+	ids   []string
+	items map[string]*item[T]
+}
+
+func newStore[T any]() store[T] {
+	return store[T]{items: map[string]*item[T]{}}
+}
+
+func (s *store[T]) add(id string, res T) *item[T] {
+	it := &item[T]{res: res}
+	s.ids = append(s.ids, id)
+	s.items[id] = it
+	return it
+}
+
+func (s *store[T]) get(id string) (*item[T], bool) {
+	it, found := s.items[id]
+	return it, found
+}
+
+func (s *store[T]) delete(id string) {
+	delete(s.items, id)
+	s.ids = slices.DeleteFunc(s.ids, func(i string) bool { return i == id })
+}
+
+// all returns all items, in creation order.
+func (s *store[T]) all() []*item[T] {
+	items := make([]*item[T], 0, len(s.ids))
+	for _, id := range s.ids {
+		items = append(items, s.items[id])
+	}
+	return items
+}
+
+// find returns the first resource matching a predicate.
+func (s *store[T]) find(match func(res *T) bool) (*item[T], bool) {
+	for _, it := range s.all() {
+		if match(&it.res) {
+			return it, true
+		}
+	}
+	return nil, false
+}
+
+// read counts a read of all resources, and applies the transitions having no reads left.
+func (s *store[T]) read() {
+	for _, id := range slices.Clone(s.ids) {
+		it, found := s.items[id]
+		switch {
+		case !found || it.next == nil:
+		case it.reads > 0:
+			it.reads--
+		default:
+			s.apply(id, it)
+		}
+	}
+}
+
+func (s *store[T]) apply(id string, it *item[T]) {
+	next := it.next
+	it.next = nil
+	if !next(&it.res) {
+		s.delete(id)
+	}
+}
+
+// list returns copies of the resources matching a predicate, in creation order.
+func (s *store[T]) list(match func(res *T) bool) []T {
+	var res []T
+	for _, it := range s.all() {
+		// the predicate gets the copy, which it may complete (e.g. with tags) without altering the stored resource.
+		cp := clone(it.res)
+		if match(&cp) {
+			res = append(res, cp)
+		}
+	}
+	return res
+}
+
+// transition schedules a lifecycle transition of a resource, replacing any pending transition.
+// The transition is applied after Options.TransientReads reads, or immediately if 0.
+func transition[T any](c *Client, s *store[T], id string, next func(res *T) bool) {
+	it, found := s.get(id)
+	if !found {
+		return
+	}
+	it.reads, it.next = c.opts.TransientReads, next
+	if it.reads == 0 {
+		s.apply(id, it)
+	}
+}
+
+// clone deep copies a resource, so that callers cannot alter the state of the fake.
+func clone[T any](res T) T {
+	buf, err := json.Marshal(res)
+	if err != nil {
+		panic(fmt.Sprintf("clone %T: %v", res, err))
+	}
+	var cp T
+	if err := json.Unmarshal(buf, &cp); err != nil {
+		panic(fmt.Sprintf("clone %T: %v", res, err))
+	}
+	return cp
+}
+
+// in reports whether a value is listed in a filter. Nil or empty filters match all values.
+func in[T comparable](filter *[]T, values ...T) bool {
+	if filter == nil || len(*filter) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if slices.Contains(*filter, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTags reports whether tags match the TagKeys, TagValues and Tags fields of a filter.
+// Multiple values of a field match any value.
+func matchTags[F tags.FiltersType](filters *F, rtags []osc.ResourceTag) bool {
+	if filters == nil {
+		return true
+	}
+	v := reflect.ValueOf(filters).Elem()
+	keys := v.FieldByName("TagKeys").Interface().(*[]string)
+	values := v.FieldByName("TagValues").Interface().(*[]string)
+	kvs := v.FieldByName("Tags").Interface().(*[]string)
+	switch {
+	case keys != nil && len(*keys) > 0 && !slices.ContainsFunc(*keys, func(k string) bool { return tags.Has(rtags, k) }):
+		return false
+	case values != nil && len(*values) > 0 && !slices.ContainsFunc(rtags, func(t osc.ResourceTag) bool { return slices.Contains(*values, t.Value) }):
+		return false
+	case kvs != nil && len(*kvs) > 0 && !slices.ContainsFunc(*kvs, func(kv string) bool {
+		k, v, _ := strings.Cut(kv, "=")
+		return tags.Has(rtags, k, v)
+	}):
+		return false
+	default:
+		return true
+	}
+}
+
+// paginate returns a page of results, and the token of the next page if any.
+func paginate[T any](c *Client, res []T, token *string, perPage *int) ([]T, *string, error) {
+	var offset int
+	if token != nil && *token != "" {
+		buf, err := base64.StdEncoding.DecodeString(*token)
+		if err == nil {
+			offset, err = strconv.Atoi(string(buf))
+		}
+		if err != nil || offset < 0 || offset > len(res) {
+			return nil, nil, c.fail(errInvalidParameter, "invalid NextPageToken %q", *token)
+		}
+	}
+	res = res[offset:]
+	if perPage == nil {
+		return res, nil, nil
+	}
+	if *perPage < 1 || *perPage > maxResultsPerPage {
+		return nil, nil, c.fail(errInvalidParameter, "ResultsPerPage must be between 1 and %d", maxResultsPerPage)
+	}
+	if len(res) <= *perPage {
+		return res, nil, nil
+	}
+	next := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset + *perPage)))
+	return res[:*perPage], &next, nil
+}
@@ -0,0 +1,230 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/sdk/batch"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/fixtures"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createVolume(t *testing.T, c *fake_osc.Client) osc.Volume {
+	res, err := c.CreateVolume(t.Context(), osc.CreateVolumeRequest{SubregionName: fake_osc.DefaultSubregion, Size: ptr.To(10)})
+	require.NoError(t, err)
+	return *res.Volume
+}
+
+func createVm(t *testing.T, c *fake_osc.Client) osc.Vm {
+	res, err := c.CreateVms(t.Context(), osc.CreateVmsRequest{ImageId: "ami-foo"})
+	require.NoError(t, err)
+	return (*res.Vms)[0]
+}
+
+func readVolume(t *testing.T, c *fake_osc.Client, id string) osc.Volume {
+	res, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{VolumeIds: &[]string{id}}})
+	require.NoError(t, err)
+	require.Len(t, *res.Volumes, 1)
+	return (*res.Volumes)[0]
+}
+
+func TestClient_Lifecycle(t *testing.T) {
+	t.Run("Resources are in a transient state during TransientReads reads", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{TransientReads: 2})
+		vol := createVolume(t, c)
+		assert.Equal(t, osc.VolumeStateCreating, vol.State)
+		assert.Equal(t, osc.VolumeStateCreating, readVolume(t, c, vol.VolumeId).State)
+		assert.Equal(t, osc.VolumeStateCreating, readVolume(t, c, vol.VolumeId).State)
+		assert.Equal(t, osc.VolumeStateAvailable, readVolume(t, c, vol.VolumeId).State)
+	})
+	t.Run("Resources are created in their final state by default", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		assert.Equal(t, osc.VolumeStateAvailable, createVolume(t, c).State)
+		assert.Equal(t, osc.VmStateRunning, createVm(t, c).State)
+	})
+	t.Run("Deleted volumes are deleting, then not found", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{TransientReads: 1})
+		vol := createVolume(t, c)
+		readVolume(t, c, vol.VolumeId)
+		readVolume(t, c, vol.VolumeId)
+		_, err := c.DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: vol.VolumeId})
+		require.NoError(t, err)
+		assert.Equal(t, osc.VolumeStateDeleting, readVolume(t, c, vol.VolumeId).State)
+		res, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, *res.Volumes)
+	})
+	t.Run("The batcher waits until volumes are available", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{TransientReads: 3})
+		vol := createVolume(t, c)
+		b := batch.NewVolumeBatcherByID(10*time.Millisecond, c)
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+		defer cancel()
+		go b.Run(ctx)
+		res, err := b.WaitUntil(ctx, vol.VolumeId, func(v *osc.Volume) (bool, error) {
+			return v.State == osc.VolumeStateAvailable, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, osc.VolumeStateAvailable, res.State)
+	})
+}
+
+func TestClient_Read(t *testing.T) {
+	c := fake_osc.NewClient(fake_osc.Options{})
+	var ids []string
+	for range 5 {
+		ids = append(ids, createVolume(t, c).VolumeId)
+	}
+	_, err := c.CreateTags(t.Context(), osc.CreateTagsRequest{
+		ResourceIds: ids[1:3],
+		Tags:        []osc.ResourceTag{{Key: "foo", Value: "bar"}},
+	})
+	require.NoError(t, err)
+	t.Run("Tag filters are applied", func(t *testing.T) {
+		res, err := c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{Tags: &[]string{"foo=bar"}}})
+		require.NoError(t, err)
+		require.Len(t, *res.Volumes, 2)
+		assert.Equal(t, ids[1], (*res.Volumes)[0].VolumeId)
+		assert.Equal(t, []osc.ResourceTag{{Key: "foo", Value: "bar"}}, (*res.Volumes)[0].Tags)
+		res, err = c.ReadVolumes(t.Context(), osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{TagKeys: &[]string{"bar"}}})
+		require.NoError(t, err)
+		assert.Empty(t, *res.Volumes)
+	})
+	t.Run("Results are paginated", func(t *testing.T) {
+		var read []string
+		req := osc.ReadVolumesRequest{ResultsPerPage: ptr.To(2)}
+		for {
+			res, err := c.ReadVolumes(t.Context(), req)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(*res.Volumes), 2)
+			for _, vol := range *res.Volumes {
+				read = append(read, vol.VolumeId)
+			}
+			if res.NextPageToken == nil {
+				break
+			}
+			req.NextPageToken = res.NextPageToken
+		}
+		assert.Equal(t, ids, read)
+	})
+	t.Run("Tags are listed", func(t *testing.T) {
+		res, err := c.ReadTags(t.Context(), osc.ReadTagsRequest{Filters: &osc.FiltersTag{ResourceIds: &[]string{ids[1]}}})
+		require.NoError(t, err)
+		assert.Equal(t, []osc.Tag{{ResourceId: ids[1], ResourceType: osc.TagResourceTypeVolume, Key: "foo", Value: "bar"}}, *res.Tags)
+	})
+	t.Run("Tags are deleted if both key and value match", func(t *testing.T) {
+		_, err := c.DeleteTags(t.Context(), osc.DeleteTagsRequest{ResourceIds: ids[2:3], Tags: []osc.ResourceTag{{Key: "foo"}}})
+		require.NoError(t, err)
+		assert.Len(t, readVolume(t, c, ids[2]).Tags, 1, "an empty value only matches empty values")
+		_, err = c.DeleteTags(t.Context(), osc.DeleteTagsRequest{ResourceIds: ids[2:3], Tags: []osc.ResourceTag{{Key: "foo", Value: "bar"}}})
+		require.NoError(t, err)
+		assert.Empty(t, readVolume(t, c, ids[2]).Tags)
+	})
+	t.Run("Results cannot alter the state of the fake", func(t *testing.T) {
+		vol := readVolume(t, c, ids[1])
+		vol.Tags[0].Value = "baz"
+		assert.Equal(t, "bar", readVolume(t, c, ids[1]).Tags[0].Value)
+	})
+}
+
+func TestClient_Errors(t *testing.T) {
+	t.Run("Errors are realistic OAPI errors", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		_, err := c.DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: "vol-foo"})
+		require.Error(t, err)
+		assert.NotNil(t, osc.AsErrorResponse(err))
+		assert.True(t, osc.IsNotFound(err))
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrNotFound))
+		_, err = c.CreateVolume(t.Context(), osc.CreateVolumeRequest{SubregionName: fake_osc.DefaultSubregion, Size: ptr.To(0)})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInvalidParameter))
+	})
+	t.Run("Calls not implemented panic with the name of the call", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		assert.PanicsWithValue(t, "fake_osc: ReadNics is not implemented", func() {
+			_, _ = c.ReadNics(t.Context(), osc.ReadNicsRequest{})
+		})
+	})
+	t.Run("Linked volumes cannot be deleted, until their VM is deleted", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		vol, vm := createVolume(t, c), createVm(t, c)
+		_, err := c.LinkVolume(t.Context(), osc.LinkVolumeRequest{VolumeId: vol.VolumeId, VmId: vm.VmId, DeviceName: "/dev/xvdb"})
+		require.NoError(t, err)
+		lv := readVolume(t, c, vol.VolumeId)
+		assert.Equal(t, osc.VolumeStateInUse, lv.State)
+		assert.Equal(t, osc.LinkedVolumeStateAttached, lv.LinkedVolumes[0].State)
+		_, err = c.DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: vol.VolumeId})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInUse))
+		_, err = c.DeleteVms(t.Context(), osc.DeleteVmsRequest{VmIds: []string{vm.VmId}})
+		require.NoError(t, err)
+		_, err = c.DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: vol.VolumeId})
+		require.NoError(t, err)
+	})
+	t.Run("Nets having Subnets cannot be deleted", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		net, err := c.CreateNet(t.Context(), osc.CreateNetRequest{IpRange: "10.0.0.0/16"})
+		require.NoError(t, err)
+		_, err = c.CreateSubnet(t.Context(), osc.CreateSubnetRequest{NetId: net.Net.NetId, IpRange: "10.1.0.0/24"})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInvalidParameter), "the Subnet must be in the Net")
+		_, err = c.CreateSubnet(t.Context(), osc.CreateSubnetRequest{NetId: net.Net.NetId, IpRange: "10.0.1.0/24"})
+		require.NoError(t, err)
+		_, err = c.DeleteNet(t.Context(), osc.DeleteNetRequest{NetId: net.Net.NetId})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrDependencyViolation))
+	})
+}
+
+func TestClient_Vms(t *testing.T) {
+	t.Run("VMs get the first private IP not used in their Subnet", func(t *testing.T) {
+		f := fixtures.New()
+		net := f.Net().Build()
+		subnet := f.Subnet(net).Build()
+		vms := []osc.Vm{f.Vm().In(subnet).Build(), f.Vm().In(subnet).Build()}
+		c := fake_osc.NewClient(fake_osc.Options{})
+		require.NoError(t, c.Add(net, subnet, vms[1]))
+		res, err := c.CreateVms(t.Context(), osc.CreateVmsRequest{ImageId: "ami-foo", SubnetId: &subnet.SubnetId, MinVmsCount: ptr.To(2), MaxVmsCount: ptr.To(2)})
+		require.NoError(t, err)
+		require.Len(t, *res.Vms, 2)
+		assert.Equal(t, vms[0].PrivateIp, (*res.Vms)[0].PrivateIp)
+		assert.Equal(t, "10.0.0.6", (*res.Vms)[1].PrivateIp)
+	})
+}
+
+func TestClient_PublicIps(t *testing.T) {
+	t.Run("Public IPs are linked to VMs", func(t *testing.T) {
+		c := fake_osc.NewClient(fake_osc.Options{})
+		vm := createVm(t, c)
+		ip, err := c.CreatePublicIp(t.Context(), osc.CreatePublicIpRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "198.51.100.1", ip.PublicIp.PublicIp, "addresses are in TEST-NET-2")
+		link, err := c.LinkPublicIp(t.Context(), osc.LinkPublicIpRequest{PublicIpId: &ip.PublicIp.PublicIpId, VmId: &vm.VmId})
+		require.NoError(t, err)
+		_, err = c.LinkPublicIp(t.Context(), osc.LinkPublicIpRequest{PublicIpId: &ip.PublicIp.PublicIpId, VmId: &vm.VmId})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrConflict), "an IP cannot be linked twice without AllowRelink")
+
+		res, err := c.ReadPublicIps(t.Context(), osc.ReadPublicIpsRequest{Filters: &osc.FiltersPublicIp{VmIds: &[]string{vm.VmId}}})
+		require.NoError(t, err)
+		require.Len(t, *res.PublicIps, 1)
+		assert.Equal(t, link.LinkPublicIpId, (*res.PublicIps)[0].LinkPublicIpId)
+		assert.Equal(t, vm.PrivateIp, ptr.From((*res.PublicIps)[0].PrivateIp))
+		vms, err := c.ReadVms(t.Context(), osc.ReadVmsRequest{Filters: &osc.FiltersVm{VmIds: &[]string{vm.VmId}}})
+		require.NoError(t, err)
+		assert.Equal(t, ip.PublicIp.PublicIp, ptr.From((*vms.Vms)[0].PublicIp))
+
+		_, err = c.DeletePublicIp(t.Context(), osc.DeletePublicIpRequest{PublicIpId: &ip.PublicIp.PublicIpId})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInUse))
+		_, err = c.UnlinkPublicIp(t.Context(), osc.UnlinkPublicIpRequest{LinkPublicIpId: link.LinkPublicIpId})
+		require.NoError(t, err)
+		_, err = c.DeletePublicIp(t.Context(), osc.DeletePublicIpRequest{PublicIpId: &ip.PublicIp.PublicIpId})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// CreateLoadBalancer creates a load balancer in the starting state, then active.
+func (c *Client) CreateLoadBalancer(ctx context.Context, req osc.CreateLoadBalancerRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateLoadBalancerResponse{ResponseContext: c.responseContext()}, nil
+	}
+	switch {
+	case req.LoadBalancerName == "":
+		return nil, c.fail(errMissingParameter, "LoadBalancerName is required")
+	case len(req.Listeners) == 0:
+		return nil, c.fail(errMissingParameter, "Listeners is required")
+	}
+	if _, found := c.lbs.get(req.LoadBalancerName); found {
+		return nil, c.fail(errConflict, "the load balancer %q already exists", req.LoadBalancerName)
+	}
+	lb := osc.LoadBalancer{
+		LoadBalancerName: req.LoadBalancerName,
+		LoadBalancerType: ptr.From(req.LoadBalancerType),
+		DnsName:          req.LoadBalancerName + ".lbu.example.com",
+		State:            osc.LoadBalancerStateStarting,
+		Listeners:        make([]osc.Listener, 0, len(req.Listeners)),
+		SecurityGroups:   ptr.From(req.SecurityGroups),
+		Subnets:          ptr.From(req.Subnets),
+		SubregionNames:   ptr.From(req.SubregionNames),
+		BackendVmIds:     []string{},
+		BackendIps:       []string{},
+		Tags:             append([]osc.ResourceTag{}, ptr.From(req.Tags)...),
+		PublicIp:         req.PublicIp,
+	}
+	if lb.LoadBalancerType == "" {
+		lb.LoadBalancerType = "internet-facing"
+	}
+	for _, l := range req.Listeners {
+		lb.Listeners = append(lb.Listeners, osc.Listener{
+			BackendPort:          l.BackendPort,
+			BackendProtocol:      ptr.From(l.BackendProtocol),
+			LoadBalancerPort:     l.LoadBalancerPort,
+			LoadBalancerProtocol: l.LoadBalancerProtocol,
+			ServerCertificateId:  l.ServerCertificateId,
+			PolicyNames:          []string{},
+		})
+		if lb.Listeners[len(lb.Listeners)-1].BackendProtocol == "" {
+			lb.Listeners[len(lb.Listeners)-1].BackendProtocol = l.LoadBalancerProtocol
+		}
+	}
+	for _, id := range lb.Subnets {
+		it, found := c.subnets.get(id)
+		if !found {
+			return nil, c.notFound("Subnet", id)
+		}
+		lb.NetId = ptr.To(it.res.NetId)
+		if !slices.Contains(lb.SubregionNames, it.res.SubregionName) {
+			lb.SubregionNames = append(lb.SubregionNames, it.res.SubregionName)
+		}
+	}
+	for _, id := range lb.SecurityGroups {
+		if _, found := c.sgs.get(id); !found {
+			return nil, c.notFound("security group", id)
+		}
+	}
+	if len(lb.SubregionNames) == 0 {
+		lb.SubregionNames = []string{c.opts.Subregion}
+	}
+	c.lbs.add(lb.LoadBalancerName, lb)
+	transition(c, &c.lbs, lb.LoadBalancerName, func(lb *osc.LoadBalancer) bool {
+		lb.State = osc.LoadBalancerStateActive
+		return true
+	})
+	it, _ := c.lbs.get(lb.LoadBalancerName)
+	return &osc.CreateLoadBalancerResponse{LoadBalancer: ptr.To(clone(it.res)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) DeleteLoadBalancer(ctx context.Context, req osc.DeleteLoadBalancerRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteLoadBalancerResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if _, found := c.lbs.get(req.LoadBalancerName); !found {
+		return nil, c.notFound("load balancer", req.LoadBalancerName)
+	}
+	c.lbs.delete(req.LoadBalancerName)
+	return &osc.DeleteLoadBalancerResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadLoadBalancers(ctx context.Context, req osc.ReadLoadBalancersRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancersResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadLoadBalancersResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.lbs.read()
+	f := ptr.From(req.Filters)
+	lbs := c.lbs.list(func(lb *osc.LoadBalancer) bool {
+		return in(f.LoadBalancerNames, lb.LoadBalancerName) && in(f.States, lb.State)
+	})
+	return &osc.ReadLoadBalancersResponse{LoadBalancers: &lbs, ResponseContext: c.responseContext()}, nil
+}
+
+// findLoadBalancers returns load balancers by name.
+func (c *Client) findLoadBalancers(names []string) ([]*item[osc.LoadBalancer], error) {
+	if len(names) == 0 {
+		return nil, c.fail(errMissingParameter, "LoadBalancerNames is required")
+	}
+	lbs := make([]*item[osc.LoadBalancer], 0, len(names))
+	for _, name := range names {
+		it, found := c.lbs.get(name)
+		if !found {
+			return nil, c.notFound("load balancer", name)
+		}
+		lbs = append(lbs, it)
+	}
+	return lbs, nil
+}
+
+func (c *Client) CreateLoadBalancerTags(ctx context.Context, req osc.CreateLoadBalancerTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateLoadBalancerTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateLoadBalancerTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	lbs, err := c.findLoadBalancers(req.LoadBalancerNames)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range lbs {
+		it.res.Tags = setTags(it.res.Tags, req.Tags)
+	}
+	return &osc.CreateLoadBalancerTagsResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) DeleteLoadBalancerTags(ctx context.Context, req osc.DeleteLoadBalancerTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteLoadBalancerTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteLoadBalancerTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	lbs, err := c.findLoadBalancers(req.LoadBalancerNames)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range lbs {
+		it.res.Tags = slices.DeleteFunc(it.res.Tags, func(t osc.ResourceTag) bool {
+			return slices.ContainsFunc(req.Tags, func(d osc.ResourceLoadBalancerTag) bool { return d.Key == t.Key })
+		})
+	}
+	return &osc.DeleteLoadBalancerTagsResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadLoadBalancerTags(ctx context.Context, req osc.ReadLoadBalancerTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadLoadBalancerTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadLoadBalancerTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	lbs, err := c.findLoadBalancers(req.LoadBalancerNames)
+	if err != nil {
+		return nil, err
+	}
+	tags := []osc.LoadBalancerTag{}
+	for _, it := range lbs {
+		for _, t := range it.res.Tags {
+			tags = append(tags, osc.LoadBalancerTag{LoadBalancerName: it.res.LoadBalancerName, Key: t.Key, Value: t.Value})
+		}
+	}
+	return &osc.ReadLoadBalancerTagsResponse{Tags: &tags, ResponseContext: c.responseContext()}, nil
+}
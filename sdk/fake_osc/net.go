@@ -0,0 +1,282 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+func (c *Client) CreateNet(ctx context.Context, req osc.CreateNetRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateNetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateNetResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if req.IpRange == "" {
+		return nil, c.fail(errMissingParameter, "IpRange is required")
+	}
+	if _, err := netip.ParsePrefix(req.IpRange); err != nil {
+		return nil, c.fail(errInvalidParameter, "invalid IpRange %q", req.IpRange)
+	}
+	id := c.newID("vpc")
+	c.nets.add(id, osc.Net{
+		NetId:   id,
+		IpRange: req.IpRange,
+		State:   osc.NetStatePending,
+		Tenancy: ptr.From(req.Tenancy),
+	})
+	c.register(id, osc.TagResourceTypeNet)
+	transition(c, &c.nets, id, func(n *osc.Net) bool {
+		n.State = osc.NetStateAvailable
+		return true
+	})
+	return &osc.CreateNetResponse{Net: ptr.To(c.net(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) net(id string) osc.Net {
+	it, _ := c.nets.get(id)
+	n := clone(it.res)
+	n.Tags = c.tagsOf(id)
+	return n
+}
+
+func (c *Client) DeleteNet(ctx context.Context, req osc.DeleteNetRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteNetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteNetResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if _, found := c.nets.get(req.NetId); !found {
+		return nil, c.notFound("Net", req.NetId)
+	}
+	if _, found := c.subnets.find(func(s *osc.Subnet) bool { return s.NetId == req.NetId }); found {
+		return nil, c.fail(errDependencyViolation, "the Net %q has Subnets", req.NetId)
+	}
+	if _, found := c.sgs.find(func(sg *osc.SecurityGroup) bool { return ptr.From(sg.NetId) == req.NetId }); found {
+		return nil, c.fail(errDependencyViolation, "the Net %q has security groups", req.NetId)
+	}
+	c.nets.delete(req.NetId)
+	c.unregister(req.NetId)
+	return &osc.DeleteNetResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadNets(ctx context.Context, req osc.ReadNetsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadNetsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadNetsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.nets.read()
+	f := ptr.From(req.Filters)
+	nets := c.nets.list(func(n *osc.Net) bool {
+		n.Tags = c.tagsOf(n.NetId)
+		return in(f.NetIds, n.NetId) && in(f.States, n.State) && in(f.IpRanges, n.IpRange) &&
+			matchTags(req.Filters, n.Tags)
+	})
+	nets, token, err := paginate(c, nets, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadNetsResponse{Nets: &nets, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) CreateSubnet(ctx context.Context, req osc.CreateSubnetRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateSubnetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateSubnetResponse{ResponseContext: c.responseContext()}, nil
+	}
+	switch {
+	case req.NetId == "":
+		return nil, c.fail(errMissingParameter, "NetId is required")
+	case req.IpRange == "":
+		return nil, c.fail(errMissingParameter, "IpRange is required")
+	}
+	net, found := c.nets.get(req.NetId)
+	if !found {
+		return nil, c.notFound("Net", req.NetId)
+	}
+	prefix, err := netip.ParsePrefix(req.IpRange)
+	if err != nil {
+		return nil, c.fail(errInvalidParameter, "invalid IpRange %q", req.IpRange)
+	}
+	if netPrefix := netip.MustParsePrefix(net.res.IpRange); !netPrefix.Contains(prefix.Addr()) || prefix.Bits() < netPrefix.Bits() {
+		return nil, c.fail(errInvalidParameter, "the IpRange %q is not in the Net IpRange %q", req.IpRange, net.res.IpRange)
+	}
+	id := c.newID("subnet")
+	c.subnets.add(id, osc.Subnet{
+		SubnetId:          id,
+		NetId:             req.NetId,
+		IpRange:           req.IpRange,
+		State:             osc.SubnetStatePending,
+		SubregionName:     c.subregion(req.SubregionName),
+		AvailableIpsCount: 1<<(32-prefix.Bits()) - 5,
+	})
+	c.register(id, osc.TagResourceTypeSubnet)
+	transition(c, &c.subnets, id, func(s *osc.Subnet) bool {
+		s.State = osc.SubnetStateAvailable
+		return true
+	})
+	return &osc.CreateSubnetResponse{Subnet: ptr.To(c.subnet(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) subregion(name *string) string {
+	if ptr.From(name) == "" {
+		return c.opts.Subregion
+	}
+	return *name
+}
+
+func (c *Client) subnet(id string) osc.Subnet {
+	it, _ := c.subnets.get(id)
+	s := clone(it.res)
+	s.Tags = c.tagsOf(id)
+	return s
+}
+
+func (c *Client) DeleteSubnet(ctx context.Context, req osc.DeleteSubnetRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteSubnetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteSubnetResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if _, found := c.subnets.get(req.SubnetId); !found {
+		return nil, c.notFound("Subnet", req.SubnetId)
+	}
+	if _, found := c.vms.find(func(vm *osc.Vm) bool {
+		return ptr.From(vm.SubnetId) == req.SubnetId && vm.State != osc.VmStateTerminated
+	}); found {
+		return nil, c.fail(errDependencyViolation, "the Subnet %q has VMs", req.SubnetId)
+	}
+	c.subnets.delete(req.SubnetId)
+	c.unregister(req.SubnetId)
+	return &osc.DeleteSubnetResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadSubnets(ctx context.Context, req osc.ReadSubnetsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadSubnetsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadSubnetsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.subnets.read()
+	f := ptr.From(req.Filters)
+	subnets := c.subnets.list(func(s *osc.Subnet) bool {
+		s.Tags = c.tagsOf(s.SubnetId)
+		return in(f.SubnetIds, s.SubnetId) && in(f.NetIds, s.NetId) && in(f.States, s.State) &&
+			in(f.SubregionNames, s.SubregionName) && in(f.IpRanges, s.IpRange) && matchTags(req.Filters, s.Tags)
+	})
+	subnets, token, err := paginate(c, subnets, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadSubnetsResponse{Subnets: &subnets, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) CreateSecurityGroup(ctx context.Context, req osc.CreateSecurityGroupRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateSecurityGroupResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateSecurityGroupResponse{ResponseContext: c.responseContext()}, nil
+	}
+	switch {
+	case req.SecurityGroupName == "":
+		return nil, c.fail(errMissingParameter, "SecurityGroupName is required")
+	case req.Description == "":
+		return nil, c.fail(errMissingParameter, "Description is required")
+	}
+	if req.NetId != nil {
+		if _, found := c.nets.get(*req.NetId); !found {
+			return nil, c.notFound("Net", *req.NetId)
+		}
+	}
+	if _, found := c.sgs.find(func(sg *osc.SecurityGroup) bool {
+		return sg.SecurityGroupName == req.SecurityGroupName && ptr.Equal(sg.NetId, req.NetId)
+	}); found {
+		return nil, c.fail(errConflict, "the security group %q already exists", req.SecurityGroupName)
+	}
+	id := c.newID("sg")
+	c.sgs.add(id, osc.SecurityGroup{
+		SecurityGroupId:   id,
+		SecurityGroupName: req.SecurityGroupName,
+		Description:       req.Description,
+		NetId:             req.NetId,
+		InboundRules:      []osc.SecurityGroupRule{},
+		OutboundRules:     []osc.SecurityGroupRule{},
+	})
+	c.register(id, osc.TagResourceTypeSecurityGroup)
+	return &osc.CreateSecurityGroupResponse{SecurityGroup: ptr.To(c.securityGroup(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) securityGroup(id string) osc.SecurityGroup {
+	it, _ := c.sgs.get(id)
+	sg := clone(it.res)
+	sg.Tags = c.tagsOf(id)
+	return sg
+}
+
+func (c *Client) DeleteSecurityGroup(ctx context.Context, req osc.DeleteSecurityGroupRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteSecurityGroupResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteSecurityGroupResponse{ResponseContext: c.responseContext()}, nil
+	}
+	var (
+		it    *item[osc.SecurityGroup]
+		found bool
+	)
+	switch {
+	case req.SecurityGroupId != nil:
+		it, found = c.sgs.get(*req.SecurityGroupId)
+	case req.SecurityGroupName != nil:
+		it, found = c.sgs.find(func(sg *osc.SecurityGroup) bool { return sg.SecurityGroupName == *req.SecurityGroupName })
+	default:
+		return nil, c.fail(errMissingParameter, "SecurityGroupId or SecurityGroupName is required")
+	}
+	if !found {
+		return nil, c.notFound("security group", ptr.From(req.SecurityGroupId)+ptr.From(req.SecurityGroupName))
+	}
+	id := it.res.SecurityGroupId
+	if _, found := c.vms.find(func(vm *osc.Vm) bool {
+		return vm.State != osc.VmStateTerminated && slices.ContainsFunc(vm.SecurityGroups, func(sg osc.SecurityGroupLight) bool {
+			return sg.SecurityGroupId == id
+		})
+	}); found {
+		return nil, c.fail(errDependencyViolation, "the security group %q is used by VMs", id)
+	}
+	if _, found := c.lbs.find(func(lb *osc.LoadBalancer) bool { return slices.Contains(lb.SecurityGroups, id) }); found {
+		return nil, c.fail(errDependencyViolation, "the security group %q is used by load balancers", id)
+	}
+	c.sgs.delete(id)
+	c.unregister(id)
+	return &osc.DeleteSecurityGroupResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadSecurityGroups(ctx context.Context, req osc.ReadSecurityGroupsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadSecurityGroupsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadSecurityGroupsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.sgs.read()
+	f := ptr.From(req.Filters)
+	sgs := c.sgs.list(func(sg *osc.SecurityGroup) bool {
+		sg.Tags = c.tagsOf(sg.SecurityGroupId)
+		return in(f.SecurityGroupIds, sg.SecurityGroupId) && in(f.SecurityGroupNames, sg.SecurityGroupName) &&
+			in(f.NetIds, ptr.From(sg.NetId)) && matchTags(req.Filters, sg.Tags)
+	})
+	sgs, token, err := paginate(c, sgs, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadSecurityGroupsResponse{SecurityGroups: &sgs, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
@@ -0,0 +1,195 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// publicRange is the range of public IPs (TEST-NET-2).
+var publicRange = netip.MustParsePrefix("198.51.100.0/24")
+
+// CreatePublicIp allocates a public IP. Addresses are the first addresses of publicRange not used by another public IP.
+func (c *Client) CreatePublicIp(ctx context.Context, req osc.CreatePublicIpRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreatePublicIpResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreatePublicIpResponse{ResponseContext: c.responseContext()}, nil
+	}
+	used := map[string]bool{}
+	for _, it := range c.publicIps.all() {
+		used[it.res.PublicIp] = true
+	}
+	addr := publicRange.Addr().Next()
+	for used[addr.String()] {
+		addr = addr.Next()
+	}
+	if !publicRange.Contains(addr.Next()) {
+		return nil, c.fail(errQuotaExceeded, "no public IP available in %s", publicRange)
+	}
+	id := c.newID("eipalloc")
+	c.publicIps.add(id, osc.PublicIp{
+		PublicIpId: id,
+		PublicIp:   addr.String(),
+	})
+	c.register(id, osc.TagResourceTypePublicIp)
+	return &osc.CreatePublicIpResponse{PublicIp: ptr.To(c.publicIp(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) publicIp(id string) osc.PublicIp {
+	it, _ := c.publicIps.get(id)
+	ip := clone(it.res)
+	ip.Tags = c.tagsOf(id)
+	return ip
+}
+
+// findPublicIp finds a public IP by ID or by address.
+func (c *Client) findPublicIp(id, ip *string) (*item[osc.PublicIp], error) {
+	var (
+		it    *item[osc.PublicIp]
+		found bool
+	)
+	switch {
+	case id != nil:
+		it, found = c.publicIps.get(*id)
+	case ip != nil:
+		it, found = c.publicIps.find(func(pip *osc.PublicIp) bool { return pip.PublicIp == *ip })
+	default:
+		return nil, c.fail(errMissingParameter, "PublicIpId or PublicIp is required")
+	}
+	if !found {
+		return nil, c.notFound("public IP", ptr.From(id)+ptr.From(ip))
+	}
+	return it, nil
+}
+
+func (c *Client) DeletePublicIp(ctx context.Context, req osc.DeletePublicIpRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeletePublicIpResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeletePublicIpResponse{ResponseContext: c.responseContext()}, nil
+	}
+	it, err := c.findPublicIp(req.PublicIpId, req.PublicIp)
+	if err != nil {
+		return nil, err
+	}
+	if it.res.LinkPublicIpId != nil {
+		return nil, c.fail(errInUse, "the public IP %q is linked", it.res.PublicIpId)
+	}
+	c.publicIps.delete(it.res.PublicIpId)
+	c.unregister(it.res.PublicIpId)
+	return &osc.DeletePublicIpResponse{ResponseContext: c.responseContext()}, nil
+}
+
+// LinkPublicIp links a public IP to a VM or a NIC. NICs are not checked.
+func (c *Client) LinkPublicIp(ctx context.Context, req osc.LinkPublicIpRequest, _ ...middleware.MiddlewareChainOption) (*osc.LinkPublicIpResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.LinkPublicIpResponse{ResponseContext: c.responseContext()}, nil
+	}
+	it, err := c.findPublicIp(req.PublicIpId, req.PublicIp)
+	if err != nil {
+		return nil, err
+	}
+	var vm *item[osc.Vm]
+	switch {
+	case req.VmId != nil:
+		var found bool
+		vm, found = c.vms.get(*req.VmId)
+		if !found {
+			return nil, c.notFound("VM", *req.VmId)
+		}
+		if vm.res.State != osc.VmStateRunning && vm.res.State != osc.VmStateStopped {
+			return nil, c.fail(errInvalidState, "the VM %q is %s", *req.VmId, vm.res.State)
+		}
+	case req.NicId == nil:
+		return nil, c.fail(errMissingParameter, "VmId or NicId is required")
+	}
+	if it.res.LinkPublicIpId != nil {
+		if !ptr.From(req.AllowRelink) {
+			return nil, c.fail(errInUse, "the public IP %q is already linked", it.res.PublicIpId)
+		}
+		c.unlinkPublicIp(&it.res)
+	}
+	it.res.LinkPublicIpId = ptr.To(c.newID("eipassoc"))
+	it.res.NicId, it.res.PrivateIp = req.NicId, req.PrivateIp
+	if vm != nil {
+		if vm.res.PublicIp != nil {
+			if old, found := c.publicIps.find(func(pip *osc.PublicIp) bool { return pip.PublicIp == *vm.res.PublicIp }); found {
+				c.unlinkPublicIp(&old.res)
+			}
+		}
+		it.res.VmId, it.res.PrivateIp = req.VmId, ptr.To(vm.res.PrivateIp)
+		vm.res.PublicIp = ptr.To(it.res.PublicIp)
+	}
+	return &osc.LinkPublicIpResponse{LinkPublicIpId: it.res.LinkPublicIpId, ResponseContext: c.responseContext()}, nil
+}
+
+// unlinkPublicIp unlinks a public IP from its VM or NIC.
+func (c *Client) unlinkPublicIp(ip *osc.PublicIp) {
+	if vm, found := c.vms.get(ptr.From(ip.VmId)); found && ptr.From(vm.res.PublicIp) == ip.PublicIp {
+		vm.res.PublicIp = nil
+	}
+	ip.LinkPublicIpId, ip.VmId, ip.NicId, ip.PrivateIp = nil, nil, nil, nil
+}
+
+func (c *Client) UnlinkPublicIp(ctx context.Context, req osc.UnlinkPublicIpRequest, _ ...middleware.MiddlewareChainOption) (*osc.UnlinkPublicIpResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.UnlinkPublicIpResponse{ResponseContext: c.responseContext()}, nil
+	}
+	var (
+		it    *item[osc.PublicIp]
+		found bool
+	)
+	switch {
+	case req.LinkPublicIpId != nil:
+		it, found = c.publicIps.find(func(pip *osc.PublicIp) bool { return ptr.From(pip.LinkPublicIpId) == *req.LinkPublicIpId })
+		if !found {
+			return nil, c.notFound("public IP link", *req.LinkPublicIpId)
+		}
+	case req.PublicIp != nil:
+		it, found = c.publicIps.find(func(pip *osc.PublicIp) bool { return pip.PublicIp == *req.PublicIp })
+		if !found {
+			return nil, c.notFound("public IP", *req.PublicIp)
+		}
+		if it.res.LinkPublicIpId == nil {
+			return nil, c.fail(errInvalidState, "the public IP %q is not linked", *req.PublicIp)
+		}
+	default:
+		return nil, c.fail(errMissingParameter, "LinkPublicIpId or PublicIp is required")
+	}
+	c.unlinkPublicIp(&it.res)
+	return &osc.UnlinkPublicIpResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadPublicIps(ctx context.Context, req osc.ReadPublicIpsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadPublicIpsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadPublicIpsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.publicIps.read()
+	f := ptr.From(req.Filters)
+	ips := c.publicIps.list(func(ip *osc.PublicIp) bool {
+		ip.Tags = c.tagsOf(ip.PublicIpId)
+		return in(f.PublicIpIds, ip.PublicIpId) && in(f.PublicIps, ip.PublicIp) &&
+			in(f.LinkPublicIpIds, ptr.From(ip.LinkPublicIpId)) && in(f.VmIds, ptr.From(ip.VmId)) &&
+			in(f.NicIds, ptr.From(ip.NicId)) && in(f.PrivateIps, ptr.From(ip.PrivateIp)) && matchTags(req.Filters, ip.Tags)
+	})
+	ips, token, err := paginate(c, ips, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadPublicIpsResponse{PublicIps: &ips, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
@@ -0,0 +1,106 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// setTags adds tags, replacing the values of existing keys.
+func setTags(tags, add []osc.ResourceTag) []osc.ResourceTag {
+	tags = slices.Clone(tags)
+	for _, t := range add {
+		if i := slices.IndexFunc(tags, func(e osc.ResourceTag) bool { return e.Key == t.Key }); i >= 0 {
+			tags[i].Value = t.Value
+		} else {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+func (c *Client) CreateTags(ctx context.Context, req osc.CreateTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if err := c.checkTags(req.ResourceIds, req.Tags); err != nil {
+		return nil, err
+	}
+	for _, id := range req.ResourceIds {
+		c.tags[id] = setTags(c.tags[id], req.Tags)
+	}
+	return &osc.CreateTagsResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) checkTags(ids []string, tags []osc.ResourceTag) error {
+	switch {
+	case len(ids) == 0:
+		return c.fail(errMissingParameter, "ResourceIds is required")
+	case len(tags) == 0:
+		return c.fail(errMissingParameter, "Tags is required")
+	}
+	for _, id := range ids {
+		if _, found := c.types[id]; !found {
+			return c.notFound("resource", id)
+		}
+	}
+	return nil
+}
+
+// DeleteTags deletes tags matching both key and value, an empty value only matching empty values.
+func (c *Client) DeleteTags(ctx context.Context, req osc.DeleteTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if err := c.checkTags(req.ResourceIds, req.Tags); err != nil {
+		return nil, err
+	}
+	for _, id := range req.ResourceIds {
+		c.tags[id] = slices.DeleteFunc(c.tags[id], func(t osc.ResourceTag) bool {
+			return slices.ContainsFunc(req.Tags, func(d osc.ResourceTag) bool {
+				return d.Key == t.Key && d.Value == t.Value
+			})
+		})
+	}
+	return &osc.DeleteTagsResponse{ResponseContext: c.responseContext()}, nil
+}
+
+// ReadTags lists tags, sorted by resource ID and key.
+func (c *Client) ReadTags(ctx context.Context, req osc.ReadTagsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadTagsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadTagsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	f := ptr.From(req.Filters)
+	tags := []osc.Tag{}
+	for id, rtags := range c.tags {
+		typ := c.types[id]
+		for _, t := range rtags {
+			if in(f.ResourceIds, id) && in(f.ResourceTypes, string(typ)) && in(f.Keys, t.Key) && in(f.Values, t.Value) {
+				tags = append(tags, osc.Tag{ResourceId: id, ResourceType: typ, Key: t.Key, Value: t.Value})
+			}
+		}
+	}
+	slices.SortFunc(tags, func(a, b osc.Tag) int {
+		return cmp.Or(cmp.Compare(a.ResourceId, b.ResourceId), cmp.Compare(a.Key, b.Key))
+	})
+	tags, token, err := paginate(c, tags, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadTagsResponse{Tags: &tags, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
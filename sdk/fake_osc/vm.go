@@ -0,0 +1,211 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// DefaultVmType is the type of VMs created without type.
+const DefaultVmType = "tinav6.c1r1p2"
+
+// CreateVms creates VMs in the pending state, then running.
+func (c *Client) CreateVms(ctx context.Context, req osc.CreateVmsRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateVmsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateVmsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if req.ImageId == "" {
+		return nil, c.fail(errMissingParameter, "ImageId is required")
+	}
+	vm := osc.Vm{
+		ImageId:             req.ImageId,
+		VmType:              ptr.From(req.VmType),
+		State:               osc.VmStatePending,
+		Placement:           osc.Placement{SubregionName: c.opts.Subregion},
+		BlockDeviceMappings: []osc.BlockDeviceMappingCreated{},
+		SecurityGroups:      []osc.SecurityGroupLight{},
+		KeypairName:         req.KeypairName,
+		UserData:            ptr.From(req.UserData),
+		DeletionProtection:  ptr.From(req.DeletionProtection),
+	}
+	if vm.VmType == "" {
+		vm.VmType = DefaultVmType
+	}
+	if req.Placement != nil && req.Placement.SubregionName != "" {
+		vm.Placement.SubregionName = req.Placement.SubregionName
+	}
+	var subnet *osc.Subnet
+	if req.SubnetId != nil {
+		it, found := c.subnets.get(*req.SubnetId)
+		if !found {
+			return nil, c.notFound("Subnet", *req.SubnetId)
+		}
+		subnet = &it.res
+		vm.SubnetId, vm.NetId = ptr.To(subnet.SubnetId), ptr.To(subnet.NetId)
+		vm.Placement.SubregionName = subnet.SubregionName
+	}
+	for _, id := range req.SecurityGroupIds {
+		it, found := c.sgs.get(id)
+		if !found {
+			return nil, c.notFound("security group", id)
+		}
+		vm.SecurityGroups = append(vm.SecurityGroups, osc.SecurityGroupLight{SecurityGroupId: id, SecurityGroupName: it.res.SecurityGroupName})
+	}
+	for _, name := range req.SecurityGroups {
+		it, found := c.sgs.find(func(sg *osc.SecurityGroup) bool {
+			return sg.SecurityGroupName == name && ptr.Equal(sg.NetId, vm.NetId)
+		})
+		if !found {
+			return nil, c.notFound("security group", name)
+		}
+		vm.SecurityGroups = append(vm.SecurityGroups, osc.SecurityGroupLight{SecurityGroupId: it.res.SecurityGroupId, SecurityGroupName: name})
+	}
+	count := ptr.From(req.MaxVmsCount)
+	if count == 0 {
+		count = 1
+	}
+	if count < ptr.From(req.MinVmsCount) {
+		return nil, c.fail(errInvalidParameter, "MaxVmsCount must be greater than MinVmsCount")
+	}
+	reservation := c.newID("r")
+	vms := make([]osc.Vm, 0, count)
+	for i := range count {
+		vm := vm
+		vm.VmId = c.newID("i")
+		vm.ReservationId = reservation
+		vm.LaunchNumber = i
+		vm.PrivateIp = c.privateIp(subnet)
+		c.vms.add(vm.VmId, vm)
+		c.register(vm.VmId, osc.TagResourceTypeVm)
+		transition(c, &c.vms, vm.VmId, func(vm *osc.Vm) bool {
+			vm.State = osc.VmStateRunning
+			return true
+		})
+		vms = append(vms, c.vm(vm.VmId))
+	}
+	return &osc.CreateVmsResponse{Vms: &vms, ResponseContext: c.responseContext()}, nil
+}
+
+// privateIp returns the first private IP of a Subnet not used by a stored VM, or a new IP outside any Net if subnet
+// is nil. The first four IPs of a Subnet are reserved.
+func (c *Client) privateIp(subnet *osc.Subnet) string {
+	if subnet == nil {
+		n := c.next()
+		return fmt.Sprintf("10.9.%d.%d", n/256%256, n%256)
+	}
+	used := map[string]bool{}
+	for _, it := range c.vms.all() {
+		used[it.res.PrivateIp] = true
+	}
+	addr := netip.MustParsePrefix(subnet.IpRange).Masked().Addr()
+	for range 4 {
+		addr = addr.Next()
+	}
+	for used[addr.String()] {
+		addr = addr.Next()
+	}
+	return addr.String()
+}
+
+func (c *Client) vm(id string) osc.Vm {
+	it, _ := c.vms.get(id)
+	vm := clone(it.res)
+	vm.Tags = c.tagsOf(id)
+	return vm
+}
+
+// DeleteVms moves VMs to the shutting-down state, then terminated.
+// Terminated VMs are still listed by ReadVms. Their volumes and public IPs are unlinked.
+func (c *Client) DeleteVms(ctx context.Context, req osc.DeleteVmsRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteVmsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteVmsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if len(req.VmIds) == 0 {
+		return nil, c.fail(errMissingParameter, "VmIds is required")
+	}
+	for _, id := range req.VmIds {
+		it, found := c.vms.get(id)
+		if !found {
+			return nil, c.notFound("VM", id)
+		}
+		if it.res.DeletionProtection {
+			return nil, c.fail(errInvalidState, "the VM %q is protected against deletion", id)
+		}
+	}
+	infos := make([]osc.VmStateInfo, 0, len(req.VmIds))
+	for _, id := range req.VmIds {
+		it, _ := c.vms.get(id)
+		previous := it.res.State
+		if previous != osc.VmStateTerminated {
+			it.res.State = osc.VmStateShuttingDown
+			transition(c, &c.vms, id, func(vm *osc.Vm) bool {
+				c.terminate(vm)
+				return true
+			})
+		}
+		infos = append(infos, osc.VmStateInfo{
+			VmId:          ptr.To(id),
+			PreviousState: ptr.To(string(previous)),
+			CurrentState:  ptr.To(string(it.res.State)),
+		})
+	}
+	return &osc.DeleteVmsResponse{Vms: &infos, ResponseContext: c.responseContext()}, nil
+}
+
+// terminate terminates a VM, and unlinks its volumes and public IPs.
+func (c *Client) terminate(vm *osc.Vm) {
+	vm.State = osc.VmStateTerminated
+	vm.BlockDeviceMappings = []osc.BlockDeviceMappingCreated{}
+	vm.PublicIp = nil
+	for _, it := range c.volumes.all() {
+		if len(it.res.LinkedVolumes) > 0 && it.res.LinkedVolumes[0].VmId == vm.VmId {
+			it.next = nil
+			it.res.LinkedVolumes = []osc.LinkedVolume{}
+			it.res.State = osc.VolumeStateAvailable
+		}
+	}
+	for _, it := range c.publicIps.all() {
+		if ptr.From(it.res.VmId) == vm.VmId {
+			c.unlinkPublicIp(&it.res)
+		}
+	}
+}
+
+func (c *Client) ReadVms(ctx context.Context, req osc.ReadVmsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadVmsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadVmsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.vms.read()
+	f := ptr.From(req.Filters)
+	vms := c.vms.list(func(vm *osc.Vm) bool {
+		vm.Tags = c.tagsOf(vm.VmId)
+		sgIds := make([]string, 0, len(vm.SecurityGroups))
+		for _, sg := range vm.SecurityGroups {
+			sgIds = append(sgIds, sg.SecurityGroupId)
+		}
+		return in(f.VmIds, vm.VmId) && in(f.VmStateNames, vm.State) && in(f.NetIds, ptr.From(vm.NetId)) &&
+			in(f.SubnetIds, ptr.From(vm.SubnetId)) && in(f.SubregionNames, vm.Placement.SubregionName) &&
+			in(f.PrivateIps, vm.PrivateIp) && in(f.PublicIps, ptr.From(vm.PublicIp)) && in(f.ImageIds, vm.ImageId) &&
+			in(f.VmTypes, vm.VmType) && in(f.SecurityGroupIds, sgIds...) && matchTags(req.Filters, vm.Tags)
+	})
+	vms, token, err := paginate(c, vms, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadVmsResponse{Vms: &vms, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
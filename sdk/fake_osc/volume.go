@@ -0,0 +1,303 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fake_osc
+
+import (
+	"context"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// Volume sizes, in GiB.
+const (
+	minVolumeSize = 1
+	maxVolumeSize = 14901
+)
+
+// CreateVolume creates a volume in the creating state, then available.
+func (c *Client) CreateVolume(ctx context.Context, req osc.CreateVolumeRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateVolumeResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if req.SubregionName == "" {
+		return nil, c.fail(errMissingParameter, "SubregionName is required")
+	}
+	size := ptr.From(req.Size)
+	switch {
+	case req.SnapshotId != nil:
+		it, found := c.snapshots.get(*req.SnapshotId)
+		if !found {
+			return nil, c.notFound("snapshot", *req.SnapshotId)
+		}
+		if it.res.State != osc.SnapshotStateCompleted {
+			return nil, c.fail(errInvalidState, "the snapshot %q is not completed", *req.SnapshotId)
+		}
+		size = max(size, it.res.VolumeSize)
+	case req.Size == nil:
+		return nil, c.fail(errMissingParameter, "Size or SnapshotId is required")
+	}
+	if size < minVolumeSize || size > maxVolumeSize {
+		return nil, c.fail(errInvalidParameter, "Size must be between %d and %d", minVolumeSize, maxVolumeSize)
+	}
+	typ := ptr.From(req.VolumeType)
+	if typ == "" {
+		typ = osc.VolumeTypeStandard
+	}
+	id := c.newID("vol")
+	c.volumes.add(id, osc.Volume{
+		VolumeId:      id,
+		State:         osc.VolumeStateCreating,
+		Size:          size,
+		SubregionName: req.SubregionName,
+		VolumeType:    typ,
+		Iops:          ptr.From(req.Iops),
+		SnapshotId:    req.SnapshotId,
+		ClientToken:   req.ClientToken,
+		LinkedVolumes: []osc.LinkedVolume{},
+	})
+	c.register(id, osc.TagResourceTypeVolume)
+	transition(c, &c.volumes, id, func(vol *osc.Volume) bool {
+		vol.State = osc.VolumeStateAvailable
+		return true
+	})
+	return &osc.CreateVolumeResponse{Volume: ptr.To(c.volume(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) volume(id string) osc.Volume {
+	it, _ := c.volumes.get(id)
+	vol := clone(it.res)
+	vol.Tags = c.tagsOf(id)
+	return vol
+}
+
+// DeleteVolume moves a volume to the deleting state, before removing it.
+func (c *Client) DeleteVolume(ctx context.Context, req osc.DeleteVolumeRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteVolumeResponse{ResponseContext: c.responseContext()}, nil
+	}
+	it, found := c.volumes.get(req.VolumeId)
+	if !found {
+		return nil, c.notFound("volume", req.VolumeId)
+	}
+	switch it.res.State {
+	case osc.VolumeStateInUse:
+		return nil, c.fail(errInUse, "the volume %q is linked to a VM", req.VolumeId)
+	case osc.VolumeStateCreating, osc.VolumeStateDeleting:
+		return nil, c.fail(errInvalidState, "the volume %q is %s", req.VolumeId, it.res.State)
+	}
+	it.res.State = osc.VolumeStateDeleting
+	transition(c, &c.volumes, req.VolumeId, func(vol *osc.Volume) bool {
+		c.unregister(vol.VolumeId)
+		return false
+	})
+	return &osc.DeleteVolumeResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadVolumes(ctx context.Context, req osc.ReadVolumesRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadVolumesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadVolumesResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.volumes.read()
+	f := ptr.From(req.Filters)
+	vols := c.volumes.list(func(vol *osc.Volume) bool {
+		vol.Tags = c.tagsOf(vol.VolumeId)
+		var vmIds []string
+		var linkStates []osc.LinkedVolumeState
+		for _, lv := range vol.LinkedVolumes {
+			vmIds, linkStates = append(vmIds, lv.VmId), append(linkStates, lv.State)
+		}
+		return in(f.VolumeIds, vol.VolumeId) && in(f.VolumeStates, vol.State) && in(f.VolumeTypes, vol.VolumeType) &&
+			in(f.VolumeSizes, vol.Size) && in(f.SubregionNames, vol.SubregionName) && in(f.SnapshotIds, ptr.From(vol.SnapshotId)) &&
+			in(f.ClientTokens, ptr.From(vol.ClientToken)) && in(f.LinkVolumeVmIds, vmIds...) &&
+			in(f.LinkVolumeLinkStates, linkStates...) && matchTags(req.Filters, vol.Tags)
+	})
+	vols, token, err := paginate(c, vols, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadVolumesResponse{Volumes: &vols, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
+
+// LinkVolume links a volume to a VM. The link is attaching, then attached.
+func (c *Client) LinkVolume(ctx context.Context, req osc.LinkVolumeRequest, _ ...middleware.MiddlewareChainOption) (*osc.LinkVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.LinkVolumeResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if req.DeviceName == "" {
+		return nil, c.fail(errMissingParameter, "DeviceName is required")
+	}
+	vol, found := c.volumes.get(req.VolumeId)
+	if !found {
+		return nil, c.notFound("volume", req.VolumeId)
+	}
+	vm, found := c.vms.get(req.VmId)
+	if !found {
+		return nil, c.notFound("VM", req.VmId)
+	}
+	switch {
+	case vol.res.State == osc.VolumeStateInUse:
+		return nil, c.fail(errInUse, "the volume %q is already linked", req.VolumeId)
+	case vol.res.State != osc.VolumeStateAvailable:
+		return nil, c.fail(errInvalidState, "the volume %q is %s", req.VolumeId, vol.res.State)
+	case vm.res.State != osc.VmStateRunning && vm.res.State != osc.VmStateStopped:
+		return nil, c.fail(errInvalidState, "the VM %q is %s", req.VmId, vm.res.State)
+	case vol.res.SubregionName != vm.res.Placement.SubregionName:
+		return nil, c.fail(errInvalidParameter, "the volume %q and the VM %q are not in the same subregion", req.VolumeId, req.VmId)
+	case slices.ContainsFunc(vm.res.BlockDeviceMappings, func(bdm osc.BlockDeviceMappingCreated) bool {
+		return bdm.DeviceName == req.DeviceName
+	}):
+		return nil, c.fail(errInvalidParameter, "the device %q is already used by the VM %q", req.DeviceName, req.VmId)
+	}
+	vol.res.State = osc.VolumeStateInUse
+	vol.res.LinkedVolumes = []osc.LinkedVolume{{
+		VmId:       req.VmId,
+		VolumeId:   req.VolumeId,
+		DeviceName: req.DeviceName,
+		State:      osc.LinkedVolumeStateAttaching,
+	}}
+	vm.res.BlockDeviceMappings = append(vm.res.BlockDeviceMappings, osc.BlockDeviceMappingCreated{
+		DeviceName: req.DeviceName,
+		Bsu:        osc.BsuCreated{VolumeId: req.VolumeId, State: osc.LinkedVolumeStateAttaching},
+	})
+	transition(c, &c.volumes, req.VolumeId, func(vol *osc.Volume) bool {
+		c.setLinkState(vol, osc.LinkedVolumeStateAttached)
+		return true
+	})
+	return &osc.LinkVolumeResponse{ResponseContext: c.responseContext()}, nil
+}
+
+// setLinkState sets the state of the link of a volume, on the volume and its VM.
+func (c *Client) setLinkState(vol *osc.Volume, state osc.LinkedVolumeState) {
+	if len(vol.LinkedVolumes) == 0 {
+		return
+	}
+	vol.LinkedVolumes[0].State = state
+	vm, found := c.vms.get(vol.LinkedVolumes[0].VmId)
+	if !found {
+		return
+	}
+	for i := range vm.res.BlockDeviceMappings {
+		if vm.res.BlockDeviceMappings[i].Bsu.VolumeId == vol.VolumeId {
+			vm.res.BlockDeviceMappings[i].Bsu.State = state
+		}
+	}
+}
+
+// UnlinkVolume unlinks a volume from its VM. The link is detaching, then the volume is available.
+func (c *Client) UnlinkVolume(ctx context.Context, req osc.UnlinkVolumeRequest, _ ...middleware.MiddlewareChainOption) (*osc.UnlinkVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.UnlinkVolumeResponse{ResponseContext: c.responseContext()}, nil
+	}
+	vol, found := c.volumes.get(req.VolumeId)
+	if !found {
+		return nil, c.notFound("volume", req.VolumeId)
+	}
+	if len(vol.res.LinkedVolumes) == 0 || vol.res.LinkedVolumes[0].State == osc.LinkedVolumeStateDetaching {
+		return nil, c.fail(errInvalidState, "the volume %q is not linked", req.VolumeId)
+	}
+	c.setLinkState(&vol.res, osc.LinkedVolumeStateDetaching)
+	transition(c, &c.volumes, req.VolumeId, func(vol *osc.Volume) bool {
+		if vm, found := c.vms.get(vol.LinkedVolumes[0].VmId); found {
+			vm.res.BlockDeviceMappings = slices.DeleteFunc(vm.res.BlockDeviceMappings, func(bdm osc.BlockDeviceMappingCreated) bool {
+				return bdm.Bsu.VolumeId == vol.VolumeId
+			})
+		}
+		vol.LinkedVolumes = []osc.LinkedVolume{}
+		vol.State = osc.VolumeStateAvailable
+		return true
+	})
+	return &osc.UnlinkVolumeResponse{ResponseContext: c.responseContext()}, nil
+}
+
+// CreateSnapshot creates a snapshot of a volume, in the pending state, then completed.
+func (c *Client) CreateSnapshot(ctx context.Context, req osc.CreateSnapshotRequest, _ ...middleware.MiddlewareChainOption) (*osc.CreateSnapshotResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.CreateSnapshotResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if req.VolumeId == nil {
+		return nil, c.fail(errMissingParameter, "VolumeId is required")
+	}
+	vol, found := c.volumes.get(*req.VolumeId)
+	if !found {
+		return nil, c.notFound("volume", *req.VolumeId)
+	}
+	if vol.res.State != osc.VolumeStateAvailable && vol.res.State != osc.VolumeStateInUse {
+		return nil, c.fail(errInvalidState, "the volume %q is %s", *req.VolumeId, vol.res.State)
+	}
+	id := c.newID("snap")
+	c.snapshots.add(id, osc.Snapshot{
+		SnapshotId:  id,
+		VolumeId:    *req.VolumeId,
+		VolumeSize:  vol.res.Size,
+		State:       osc.SnapshotStatePending,
+		Progress:    ptr.To(0),
+		Description: req.Description,
+		ClientToken: req.ClientToken,
+	})
+	c.register(id, osc.TagResourceTypeSnapshot)
+	transition(c, &c.snapshots, id, func(snap *osc.Snapshot) bool {
+		snap.State, snap.Progress = osc.SnapshotStateCompleted, ptr.To(100)
+		return true
+	})
+	return &osc.CreateSnapshotResponse{Snapshot: ptr.To(c.snapshot(id)), ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) snapshot(id string) osc.Snapshot {
+	it, _ := c.snapshots.get(id)
+	snap := clone(it.res)
+	snap.Tags = ptr.To(c.tagsOf(id))
+	return snap
+}
+
+func (c *Client) DeleteSnapshot(ctx context.Context, req osc.DeleteSnapshotRequest, _ ...middleware.MiddlewareChainOption) (*osc.DeleteSnapshotResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.DeleteSnapshotResponse{ResponseContext: c.responseContext()}, nil
+	}
+	if _, found := c.snapshots.get(req.SnapshotId); !found {
+		return nil, c.notFound("snapshot", req.SnapshotId)
+	}
+	c.snapshots.delete(req.SnapshotId)
+	c.unregister(req.SnapshotId)
+	return &osc.DeleteSnapshotResponse{ResponseContext: c.responseContext()}, nil
+}
+
+func (c *Client) ReadSnapshots(ctx context.Context, req osc.ReadSnapshotsRequest, _ ...middleware.MiddlewareChainOption) (*osc.ReadSnapshotsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ptr.From(req.DryRun) {
+		return &osc.ReadSnapshotsResponse{ResponseContext: c.responseContext()}, nil
+	}
+	c.snapshots.read()
+	f := ptr.From(req.Filters)
+	snaps := c.snapshots.list(func(snap *osc.Snapshot) bool {
+		snap.Tags = ptr.To(c.tagsOf(snap.SnapshotId))
+		return in(f.SnapshotIds, snap.SnapshotId) && in(f.VolumeIds, snap.VolumeId) && in(f.States, snap.State) &&
+			in(f.VolumeSizes, snap.VolumeSize) && in(f.ClientTokens, ptr.From(snap.ClientToken)) &&
+			in(f.Descriptions, ptr.From(snap.Description)) && matchTags(req.Filters, *snap.Tags)
+	})
+	snaps, token, err := paginate(c, snaps, req.NextPageToken, req.ResultsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &osc.ReadSnapshotsResponse{Snapshots: &snaps, NextPageToken: token, ResponseContext: c.responseContext()}, nil
+}
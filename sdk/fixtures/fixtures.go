@@ -0,0 +1,149 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package fixtures provides fluent builders of OAPI resources, for tests.
+//
+// Resources are built by a Factory, with valid IDs (e.g. vol-1a2b3c4d), dates and IPs. Factories are deterministic:
+// two factories build the same resources when called in the same order. Linked resources are consistent, e.g. a VM
+// built with a volume references it in its block device mappings, and the volume is updated to be linked to the VM.
+//
+// Built resources may be returned by mocks, or added to fakes:
+//
+//	f := fixtures.New()
+//	net := f.Net().Build()
+//	subnet := f.Subnet(net).Build()
+//	vol := f.Volume().Size(20).Build()
+//	vm := f.Vm().In(subnet).Volume(&vol, "/dev/xvdb").Build()
+//	mockSDK.EXPECT().ReadVms(gomock.Any(), gomock.Any()).Return(&osc.ReadVmsResponse{Vms: &[]osc.Vm{vm}}, nil)
+//	err := fake.Add(net, subnet, vol, vm)
+package fixtures
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/iso8601"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// Defaults of built resources.
+const (
+	DefaultSubregion = "eu-west-2a"
+	DefaultAccountId = "123456789012"
+	DefaultVmType    = "tinav6.c1r1p2"
+	DefaultNetRange  = "10.0.0.0/16"
+)
+
+var (
+	// epoch is the creation date of the first resource, each resource being created a minute after the previous one.
+	epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// publicRange is the range of public IPs (TEST-NET-3), and privateRange the range of private IPs of VMs outside Nets.
+	publicRange  = netip.MustParsePrefix("203.0.113.0/24")
+	privateRange = netip.MustParsePrefix("10.255.0.0/16")
+)
+
+// Factory builds resources. Its fields may be changed before building resources.
+type Factory struct {
+	// Subregion is the subregion of resources. Defaults to DefaultSubregion.
+	Subregion string
+	// AccountId is the account owning resources. Defaults to DefaultAccountId.
+	AccountId string
+
+	seq       uint32
+	subnets   map[string]int
+	ips       map[string]int
+	publicIps int
+}
+
+// New returns a factory.
+func New() *Factory {
+	return &Factory{
+		Subregion: DefaultSubregion,
+		AccountId: DefaultAccountId,
+		subnets:   map[string]int{},
+		ips:       map[string]int{},
+	}
+}
+
+// next returns the next sequence number.
+func (f *Factory) next() uint32 {
+	f.seq++
+	return f.seq
+}
+
+// id returns a new ID, e.g. vol-1a2b3c4d.
+func (f *Factory) id(prefix string) string {
+	return fmt.Sprintf("%s-%08x", prefix, mix(f.next()))
+}
+
+// date returns a new creation date.
+func (f *Factory) date() iso8601.Time {
+	return iso8601.Time{Time: epoch.Add(time.Duration(f.seq) * time.Minute)}
+}
+
+// privateIp returns the next private IP of a Subnet, the first 4 IPs being reserved, or of privateRange outside Nets.
+func (f *Factory) privateIp(subnet *osc.Subnet) string {
+	key, prefix := "", privateRange
+	if subnet != nil {
+		key, prefix = subnet.SubnetId, netip.MustParsePrefix(subnet.IpRange)
+	}
+	addr := nth(prefix, 4+f.ips[key])
+	f.ips[key]++
+	return addr.String()
+}
+
+// publicIp returns the next public IP.
+func (f *Factory) publicIp() string {
+	f.publicIps++
+	return nth(publicRange, f.publicIps).String()
+}
+
+// macAddress returns a new MAC address.
+func (f *Factory) macAddress() string {
+	h := mix(f.next())
+	return fmt.Sprintf("aa:ff:ff:%02x:%02x:%02x", byte(h>>16), byte(h>>8), byte(h))
+}
+
+// region returns the region of the factory subregion.
+func (f *Factory) region() string {
+	return strings.TrimRight(f.Subregion, "abcdefghijklmnopqrstuvwxyz")
+}
+
+// privateDnsName returns the private DNS name of a private IP.
+func (f *Factory) privateDnsName(ip string) string {
+	return "ip-" + strings.ReplaceAll(ip, ".", "-") + "." + f.region() + ".compute.internal"
+}
+
+// nth returns the nth address of a prefix.
+func nth(prefix netip.Prefix, n int) netip.Addr {
+	a := prefix.Masked().Addr().As4()
+	binary.BigEndian.PutUint32(a[:], binary.BigEndian.Uint32(a[:])+uint32(n)) //nolint:gosec
+	return netip.AddrFrom4(a)
+}
+
+// mix is a bijective mixing function (murmur3 finalizer), for IDs to look random while being unique.
+func mix(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// setTag sets the value of a tag.
+func setTag(tags []osc.ResourceTag, key, value string) []osc.ResourceTag {
+	for i := range tags {
+		if tags[i].Key == key {
+			tags[i].Value = value
+			return tags
+		}
+	}
+	return append(tags, osc.ResourceTag{Key: key, Value: value})
+}
@@ -0,0 +1,137 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fixtures_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/fixtures"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resources struct {
+	net    osc.Net
+	subnet osc.Subnet
+	sg     osc.SecurityGroup
+	root   osc.Volume
+	data   osc.Volume
+	snap   osc.Snapshot
+	vm     osc.Vm
+	nic    osc.Nic
+	ip     osc.PublicIp
+	lb     osc.LoadBalancer
+}
+
+func build() resources {
+	f := fixtures.New()
+	var r resources
+	r.net = f.Net().Tag("foo", "bar").Build()
+	r.subnet = f.Subnet(r.net).Build()
+	r.sg = f.SecurityGroup("sg").In(r.net).InboundRule("tcp", 22, 22, "0.0.0.0/0").Build()
+	r.root = f.Volume().Build()
+	r.data = f.Volume().Size(20).Build()
+	r.snap = f.Snapshot(r.data).Build()
+	r.vm = f.Vm().In(r.subnet).SecurityGroups(r.sg).Volume(&r.root, "/dev/sda1").Volume(&r.data, "/dev/xvdb").Build()
+	f.Nic(r.subnet).Link(&r.vm, 0).Build()
+	r.nic = f.Nic(r.subnet).Link(&r.vm, 1).Build()
+	r.ip = f.PublicIp().Link(&r.vm).Build()
+	r.lb = f.LoadBalancer("lb").In(r.subnet).Backends(r.vm).Build()
+	return r
+}
+
+func TestFactory(t *testing.T) {
+	r := build()
+	t.Run("Resources are deterministic", func(t *testing.T) {
+		assert.Equal(t, r, build())
+	})
+	t.Run("IDs have the right prefix", func(t *testing.T) {
+		assert.Regexp(t, `^vpc-[0-9a-f]{8}$`, r.net.NetId)
+		assert.Regexp(t, `^subnet-[0-9a-f]{8}$`, r.subnet.SubnetId)
+		assert.Regexp(t, `^sg-[0-9a-f]{8}$`, r.sg.SecurityGroupId)
+		assert.Regexp(t, `^vol-[0-9a-f]{8}$`, r.data.VolumeId)
+		assert.Regexp(t, `^snap-[0-9a-f]{8}$`, r.snap.SnapshotId)
+		assert.Regexp(t, `^i-[0-9a-f]{8}$`, r.vm.VmId)
+		assert.Regexp(t, `^eni-[0-9a-f]{8}$`, r.nic.NicId)
+		assert.Regexp(t, `^eipalloc-[0-9a-f]{8}$`, r.ip.PublicIpId)
+		assert.Regexp(t, `^eipassoc-[0-9a-f]{8}$`, ptr.From(r.ip.LinkPublicIpId))
+		assert.NotEqual(t, r.root.VolumeId, r.data.VolumeId)
+	})
+	t.Run("Subnets and VMs get IPs in their Net", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.0/24", r.subnet.IpRange)
+		assert.Equal(t, r.net.NetId, r.subnet.NetId)
+		assert.Equal(t, r.subnet.SubnetId, ptr.From(r.vm.SubnetId))
+		assert.Equal(t, "10.0.0.4", r.vm.PrivateIp, "the primary NIC sets the IP of the VM")
+		assert.Equal(t, "10.0.0.5", r.nic.PrivateIps[0].PrivateIp)
+	})
+	t.Run("VMs and volumes are linked", func(t *testing.T) {
+		require.Len(t, r.vm.BlockDeviceMappings, 2)
+		assert.Equal(t, r.data.VolumeId, r.vm.BlockDeviceMappings[1].Bsu.VolumeId)
+		assert.True(t, r.vm.BlockDeviceMappings[0].Bsu.DeleteOnVmDeletion)
+		assert.Equal(t, osc.VolumeStateInUse, r.data.State)
+		assert.Equal(t, []osc.LinkedVolume{{
+			VmId: r.vm.VmId, VolumeId: r.data.VolumeId, DeviceName: "/dev/xvdb", State: osc.LinkedVolumeStateAttached,
+		}}, r.data.LinkedVolumes)
+		assert.Equal(t, r.data.Size, r.snap.VolumeSize)
+	})
+	t.Run("VMs and NICs are linked", func(t *testing.T) {
+		require.Len(t, r.vm.Nics, 2)
+		assert.Equal(t, r.nic.NicId, r.vm.Nics[1].NicId)
+		assert.Equal(t, 1, r.vm.Nics[1].LinkNic.DeviceNumber)
+		assert.Equal(t, r.vm.VmId, r.nic.LinkNic.VmId)
+		assert.Equal(t, osc.NicStateInUse, r.nic.State)
+	})
+	t.Run("VMs in a Subnet get a primary NIC, replaced by linked primary NICs", func(t *testing.T) {
+		f := fixtures.New()
+		subnet := f.Subnet(f.Net().Build()).Build()
+		sg := f.SecurityGroup("sg").Build()
+		vm := f.Vm().In(subnet).SecurityGroups(sg).Build()
+		require.Len(t, vm.Nics, 1)
+		assert.Equal(t, 0, vm.Nics[0].LinkNic.DeviceNumber)
+		assert.Equal(t, vm.PrivateIp, vm.Nics[0].PrivateIps[0].PrivateIp)
+		assert.Equal(t, vm.SecurityGroups, vm.Nics[0].SecurityGroups)
+
+		nic := f.Nic(subnet).Link(&vm, 0).Build()
+		require.Len(t, vm.Nics, 1)
+		assert.Equal(t, nic.NicId, vm.Nics[0].NicId)
+		assert.Equal(t, "10.0.0.4", nic.PrivateIps[0].PrivateIp, "the replaced NIC IP is reused")
+		assert.Equal(t, "10.0.0.4", vm.PrivateIp)
+	})
+	t.Run("VMs and public IPs are linked", func(t *testing.T) {
+		assert.Equal(t, r.ip.PublicIp, ptr.From(r.vm.PublicIp))
+		assert.Equal(t, r.vm.VmId, ptr.From(r.ip.VmId))
+		assert.Equal(t, r.vm.PrivateIp, ptr.From(r.ip.PrivateIp))
+		assert.Equal(t, r.vm.Nics[0].NicId, ptr.From(r.ip.NicId))
+		assert.Equal(t, r.ip.PublicIp, r.vm.Nics[0].LinkPublicIp.PublicIp)
+	})
+	t.Run("Load balancers reference their backends", func(t *testing.T) {
+		assert.Equal(t, []string{r.vm.VmId}, r.lb.BackendVmIds)
+		assert.Equal(t, []string{r.vm.PrivateIp}, r.lb.BackendIps)
+		assert.Equal(t, []string{r.subnet.SubnetId}, r.lb.Subnets)
+	})
+}
+
+func TestFactory_Fake(t *testing.T) {
+	r := build()
+	c := fake_osc.NewClient(fake_osc.Options{})
+	require.NoError(t, c.Add(r.net, r.subnet, r.sg, r.root, r.data, r.snap, r.vm, &r.ip, r.lb))
+	assert.Error(t, c.Add(r.nic), "NICs are not supported by the fake")
+	assert.True(t, sdkerrors.Is(c.Add(r.vm), sdkerrors.ErrConflict))
+
+	res, err := c.ReadVms(t.Context(), osc.ReadVmsRequest{Filters: &osc.FiltersVm{SubnetIds: &[]string{r.subnet.SubnetId}}})
+	require.NoError(t, err)
+	require.Len(t, *res.Vms, 1)
+	assert.Equal(t, r.vm.VmId, (*res.Vms)[0].VmId)
+	nets, err := c.ReadNets(t.Context(), osc.ReadNetsRequest{Filters: &osc.FiltersNet{Tags: &[]string{"foo=bar"}}})
+	require.NoError(t, err)
+	assert.Len(t, *nets.Nets, 1)
+	_, err = c.DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: r.data.VolumeId})
+	assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInUse), "linked volumes cannot be deleted")
+}
@@ -0,0 +1,111 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fixtures
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// LoadBalancerBuilder builds a load balancer.
+type LoadBalancerBuilder struct {
+	lb osc.LoadBalancer
+}
+
+// LoadBalancer returns a builder of an active, internet-facing load balancer, outside Nets, without backends, and with
+// a TCP listener on port 80.
+func (f *Factory) LoadBalancer(name string) *LoadBalancerBuilder {
+	return &LoadBalancerBuilder{lb: osc.LoadBalancer{
+		LoadBalancerName: name,
+		LoadBalancerType: "internet-facing",
+		DnsName:          fmt.Sprintf("%s-%d.%s.lbu.outscale.com", name, f.next(), f.region()),
+		State:            osc.LoadBalancerStateActive,
+		SubregionNames:   []string{f.Subregion},
+		Listeners: []osc.Listener{{
+			LoadBalancerPort: 80, LoadBalancerProtocol: "TCP", BackendPort: 80, BackendProtocol: "TCP", PolicyNames: []string{},
+		}},
+		HealthCheck: osc.HealthCheck{
+			CheckInterval: 30, HealthyThreshold: 10, UnhealthyThreshold: 2, Timeout: 5, Port: 80, Protocol: "TCP",
+		},
+		SourceSecurityGroup: osc.SourceSecurityGroup{
+			SecurityGroupAccountId: ptr.To("outscale-elb"),
+			SecurityGroupName:      ptr.To("outscale-elb-sg"),
+		},
+		ApplicationStickyCookiePolicies:  []osc.ApplicationStickyCookiePolicy{},
+		LoadBalancerStickyCookiePolicies: []osc.LoadBalancerStickyCookiePolicy{},
+		BackendIps:                       []string{},
+		BackendVmIds:                     []string{},
+		SecurityGroups:                   []string{},
+		Subnets:                          []string{},
+		Tags:                             []osc.ResourceTag{},
+	}}
+}
+
+// In sets the Subnets of the load balancer, its Net and subregions.
+func (b *LoadBalancerBuilder) In(subnets ...osc.Subnet) *LoadBalancerBuilder {
+	b.lb.Subnets, b.lb.SubregionNames = []string{}, []string{}
+	for _, subnet := range subnets {
+		b.lb.NetId = ptr.To(subnet.NetId)
+		b.lb.Subnets = append(b.lb.Subnets, subnet.SubnetId)
+		if !slices.Contains(b.lb.SubregionNames, subnet.SubregionName) {
+			b.lb.SubregionNames = append(b.lb.SubregionNames, subnet.SubregionName)
+		}
+	}
+	return b
+}
+
+// Internal makes the load balancer internal.
+func (b *LoadBalancerBuilder) Internal() *LoadBalancerBuilder {
+	b.lb.LoadBalancerType = "internal"
+	return b
+}
+
+// Listeners sets the listeners of the load balancer.
+func (b *LoadBalancerBuilder) Listeners(listeners ...osc.Listener) *LoadBalancerBuilder {
+	b.lb.Listeners = slices.Clone(listeners)
+	return b
+}
+
+// Backends sets the backend VMs of the load balancer, and their IPs.
+func (b *LoadBalancerBuilder) Backends(vms ...osc.Vm) *LoadBalancerBuilder {
+	b.lb.BackendVmIds, b.lb.BackendIps = []string{}, []string{}
+	for _, vm := range vms {
+		b.lb.BackendVmIds = append(b.lb.BackendVmIds, vm.VmId)
+		b.lb.BackendIps = append(b.lb.BackendIps, vm.PrivateIp)
+	}
+	return b
+}
+
+// SecurityGroups sets the security groups of the load balancer.
+func (b *LoadBalancerBuilder) SecurityGroups(sgs ...osc.SecurityGroup) *LoadBalancerBuilder {
+	b.lb.SecurityGroups = []string{}
+	for _, sg := range sgs {
+		b.lb.SecurityGroups = append(b.lb.SecurityGroups, sg.SecurityGroupId)
+	}
+	return b
+}
+
+// State sets the state of the load balancer.
+func (b *LoadBalancerBuilder) State(state osc.LoadBalancerState) *LoadBalancerBuilder {
+	b.lb.State = state
+	return b
+}
+
+// Tag sets a tag.
+func (b *LoadBalancerBuilder) Tag(key, value string) *LoadBalancerBuilder {
+	b.lb.Tags = setTag(b.lb.Tags, key, value)
+	return b
+}
+
+// Build returns the load balancer.
+func (b *LoadBalancerBuilder) Build() osc.LoadBalancer {
+	lb := b.lb
+	lb.Tags = slices.Clone(lb.Tags)
+	return lb
+}
@@ -0,0 +1,314 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fixtures
+
+import (
+	"net/netip"
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// NetBuilder builds a Net.
+type NetBuilder struct {
+	net osc.Net
+}
+
+// Net returns a builder of an available Net, with the DefaultNetRange IP range.
+func (f *Factory) Net() *NetBuilder {
+	return &NetBuilder{net: osc.Net{
+		NetId:            f.id("vpc"),
+		DhcpOptionsSetId: f.id("dopt"),
+		IpRange:          DefaultNetRange,
+		State:            osc.NetStateAvailable,
+		Tenancy:          "default",
+		Tags:             []osc.ResourceTag{},
+	}}
+}
+
+// IpRange sets the IP range of the Net.
+func (b *NetBuilder) IpRange(cidr string) *NetBuilder {
+	b.net.IpRange = cidr
+	return b
+}
+
+// State sets the state of the Net.
+func (b *NetBuilder) State(state osc.NetState) *NetBuilder {
+	b.net.State = state
+	return b
+}
+
+// Tag sets a tag.
+func (b *NetBuilder) Tag(key, value string) *NetBuilder {
+	b.net.Tags = setTag(b.net.Tags, key, value)
+	return b
+}
+
+// Build returns the Net.
+func (b *NetBuilder) Build() osc.Net {
+	net := b.net
+	net.Tags = slices.Clone(net.Tags)
+	return net
+}
+
+// SubnetBuilder builds a Subnet.
+type SubnetBuilder struct {
+	subnet osc.Subnet
+}
+
+// Subnet returns a builder of an available Subnet of a Net, having the next /24 IP range of the Net.
+func (f *Factory) Subnet(net osc.Net) *SubnetBuilder {
+	prefix := netip.MustParsePrefix(net.IpRange)
+	n := f.subnets[net.NetId]
+	f.subnets[net.NetId]++
+	return &SubnetBuilder{subnet: osc.Subnet{
+		SubnetId:          f.id("subnet"),
+		NetId:             net.NetId,
+		IpRange:           netip.PrefixFrom(nth(prefix, n*256), 24).String(),
+		SubregionName:     f.Subregion,
+		State:             osc.SubnetStateAvailable,
+		AvailableIpsCount: 251,
+		Tags:              []osc.ResourceTag{},
+	}}
+}
+
+// IpRange sets the IP range of the Subnet.
+func (b *SubnetBuilder) IpRange(cidr string) *SubnetBuilder {
+	b.subnet.IpRange = cidr
+	return b
+}
+
+// Subregion sets the subregion of the Subnet.
+func (b *SubnetBuilder) Subregion(subregion string) *SubnetBuilder {
+	b.subnet.SubregionName = subregion
+	return b
+}
+
+// State sets the state of the Subnet.
+func (b *SubnetBuilder) State(state osc.SubnetState) *SubnetBuilder {
+	b.subnet.State = state
+	return b
+}
+
+// Tag sets a tag.
+func (b *SubnetBuilder) Tag(key, value string) *SubnetBuilder {
+	b.subnet.Tags = setTag(b.subnet.Tags, key, value)
+	return b
+}
+
+// Build returns the Subnet.
+func (b *SubnetBuilder) Build() osc.Subnet {
+	subnet := b.subnet
+	subnet.Tags = slices.Clone(subnet.Tags)
+	return subnet
+}
+
+// SecurityGroupBuilder builds a security group.
+type SecurityGroupBuilder struct {
+	f  *Factory
+	sg osc.SecurityGroup
+}
+
+// SecurityGroup returns a builder of a security group without rules, outside Nets.
+func (f *Factory) SecurityGroup(name string) *SecurityGroupBuilder {
+	return &SecurityGroupBuilder{f: f, sg: osc.SecurityGroup{
+		SecurityGroupId:   f.id("sg"),
+		SecurityGroupName: name,
+		Description:       name,
+		AccountId:         f.AccountId,
+		InboundRules:      []osc.SecurityGroupRule{},
+		OutboundRules:     []osc.SecurityGroupRule{},
+		Tags:              []osc.ResourceTag{},
+	}}
+}
+
+// In sets the Net of the security group.
+func (b *SecurityGroupBuilder) In(net osc.Net) *SecurityGroupBuilder {
+	b.sg.NetId = ptr.To(net.NetId)
+	return b
+}
+
+// Description sets the description of the security group.
+func (b *SecurityGroupBuilder) Description(desc string) *SecurityGroupBuilder {
+	b.sg.Description = desc
+	return b
+}
+
+// InboundRule adds an inbound rule, allowing a protocol (tcp, udp, icmp or -1) on a port range from IP ranges.
+func (b *SecurityGroupBuilder) InboundRule(protocol string, fromPort, toPort int, ipRanges ...string) *SecurityGroupBuilder {
+	b.sg.InboundRules = append(b.sg.InboundRules, b.rule(protocol, fromPort, toPort, ipRanges))
+	return b
+}
+
+// OutboundRule adds an outbound rule, allowing a protocol (tcp, udp, icmp or -1) on a port range to IP ranges.
+func (b *SecurityGroupBuilder) OutboundRule(protocol string, fromPort, toPort int, ipRanges ...string) *SecurityGroupBuilder {
+	b.sg.OutboundRules = append(b.sg.OutboundRules, b.rule(protocol, fromPort, toPort, ipRanges))
+	return b
+}
+
+func (b *SecurityGroupBuilder) rule(protocol string, fromPort, toPort int, ipRanges []string) osc.SecurityGroupRule {
+	return osc.SecurityGroupRule{
+		SecurityGroupRuleId:   b.f.id("sgr"),
+		IpProtocol:            protocol,
+		FromPortRange:         fromPort,
+		ToPortRange:           toPort,
+		IpRanges:              append([]string{}, ipRanges...),
+		SecurityGroupsMembers: []osc.SecurityGroupsMember{},
+		ServiceIds:            []string{},
+	}
+}
+
+// Tag sets a tag.
+func (b *SecurityGroupBuilder) Tag(key, value string) *SecurityGroupBuilder {
+	b.sg.Tags = setTag(b.sg.Tags, key, value)
+	return b
+}
+
+// Build returns the security group.
+func (b *SecurityGroupBuilder) Build() osc.SecurityGroup {
+	sg := b.sg
+	sg.InboundRules, sg.OutboundRules = slices.Clone(sg.InboundRules), slices.Clone(sg.OutboundRules)
+	sg.Tags = slices.Clone(sg.Tags)
+	return sg
+}
+
+// NicBuilder builds a NIC.
+type NicBuilder struct {
+	f      *Factory
+	nic    osc.Nic
+	subnet osc.Subnet
+	vm     *osc.Vm
+	link   osc.LinkNic
+}
+
+// Nic returns a builder of an available NIC of a Subnet, having the next private IP of the Subnet.
+func (f *Factory) Nic(subnet osc.Subnet) *NicBuilder {
+	return &NicBuilder{f: f, subnet: subnet, nic: osc.Nic{
+		NicId:               f.id("eni"),
+		AccountId:           f.AccountId,
+		NetId:               subnet.NetId,
+		SubnetId:            subnet.SubnetId,
+		SubregionName:       subnet.SubregionName,
+		MacAddress:          f.macAddress(),
+		IsSourceDestChecked: true,
+		SecurityGroups:      []osc.SecurityGroupLight{},
+		State:               osc.NicStateAvailable,
+		Tags:                []osc.ResourceTag{},
+	}}
+}
+
+// Description sets the description of the NIC.
+func (b *NicBuilder) Description(desc string) *NicBuilder {
+	b.nic.Description = desc
+	return b
+}
+
+// SecurityGroups sets the security groups of the NIC.
+func (b *NicBuilder) SecurityGroups(sgs ...osc.SecurityGroup) *NicBuilder {
+	b.nic.SecurityGroups = securityGroupsLight(sgs)
+	return b
+}
+
+// Link links the NIC to a VM, as device number device. The NICs of vm are updated when building the NIC, the NIC
+// with device number 0 being the primary NIC of the VM. A primary NIC replaces the primary NIC created with the VM,
+// and takes over its private IP.
+func (b *NicBuilder) Link(vm *osc.Vm, device int) *NicBuilder {
+	b.vm = vm
+	b.link = osc.LinkNic{
+		LinkNicId:    b.f.id("eni-attach"),
+		DeviceNumber: device,
+		State:        osc.LinkNicStateAttached,
+		VmAccountId:  b.f.AccountId,
+	}
+	return b
+}
+
+// Tag sets a tag.
+func (b *NicBuilder) Tag(key, value string) *NicBuilder {
+	b.nic.Tags = setTag(b.nic.Tags, key, value)
+	return b
+}
+
+// Build returns the NIC, and links it to its VM.
+func (b *NicBuilder) Build() osc.Nic {
+	if len(b.nic.PrivateIps) == 0 {
+		// the private IP is allocated once, if Build is called again.
+		ip := b.replacedIp()
+		if ip == "" {
+			ip = b.f.privateIp(&b.subnet)
+		}
+		b.nic.PrivateDnsName = b.f.privateDnsName(ip)
+		b.nic.PrivateIps = []osc.PrivateIp{{IsPrimary: true, PrivateIp: ip, PrivateDnsName: b.nic.PrivateDnsName}}
+	}
+	nic := b.nic
+	nic.PrivateIps = slices.Clone(nic.PrivateIps)
+	nic.Tags = slices.Clone(nic.Tags)
+	if b.vm == nil {
+		return nic
+	}
+	nic.State = osc.NicStateInUse
+	link := b.link
+	link.VmId = b.vm.VmId
+	nic.LinkNic = &link
+	ips := make([]osc.PrivateIpLightForVm, 0, len(nic.PrivateIps))
+	for _, ip := range nic.PrivateIps {
+		ips = append(ips, osc.PrivateIpLightForVm{IsPrimary: ip.IsPrimary, PrivateIp: ip.PrivateIp, PrivateDnsName: ip.PrivateDnsName})
+	}
+	b.vm.Nics = slices.DeleteFunc(b.vm.Nics, func(n osc.NicLight) bool {
+		return n.LinkNic != nil && n.LinkNic.DeviceNumber == link.DeviceNumber
+	})
+	b.vm.Nics = append(b.vm.Nics, osc.NicLight{
+		NicId:               nic.NicId,
+		AccountId:           nic.AccountId,
+		Description:         nic.Description,
+		IsSourceDestChecked: nic.IsSourceDestChecked,
+		MacAddress:          nic.MacAddress,
+		NetId:               nic.NetId,
+		SubnetId:            nic.SubnetId,
+		PrivateDnsName:      nic.PrivateDnsName,
+		PrivateIps:          ips,
+		SecurityGroups:      append([]osc.SecurityGroupLight{}, nic.SecurityGroups...),
+		State:               nic.State,
+		LinkNic: &osc.LinkNicLight{
+			LinkNicId:    nic.LinkNic.LinkNicId,
+			DeviceNumber: nic.LinkNic.DeviceNumber,
+			State:        nic.LinkNic.State,
+		},
+	})
+	if link.DeviceNumber == 0 {
+		b.vm.NetId, b.vm.SubnetId = ptr.To(nic.NetId), ptr.To(nic.SubnetId)
+		b.vm.PrivateIp = nic.PrivateIps[0].PrivateIp
+		b.vm.PrivateDnsName = ptr.To(nic.PrivateDnsName)
+	}
+	return nic
+}
+
+// replacedIp returns the primary private IP of the NIC of the same Subnet replaced by the NIC, if any.
+func (b *NicBuilder) replacedIp() string {
+	if b.vm == nil {
+		return ""
+	}
+	for _, n := range b.vm.Nics {
+		if n.LinkNic == nil || n.LinkNic.DeviceNumber != b.link.DeviceNumber || n.SubnetId != b.subnet.SubnetId {
+			continue
+		}
+		for _, ip := range n.PrivateIps {
+			if ip.IsPrimary {
+				return ip.PrivateIp
+			}
+		}
+	}
+	return ""
+}
+
+func securityGroupsLight(sgs []osc.SecurityGroup) []osc.SecurityGroupLight {
+	res := make([]osc.SecurityGroupLight, 0, len(sgs))
+	for _, sg := range sgs {
+		res = append(res, osc.SecurityGroupLight{SecurityGroupId: sg.SecurityGroupId, SecurityGroupName: sg.SecurityGroupName})
+	}
+	return res
+}
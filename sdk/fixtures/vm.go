@@ -0,0 +1,214 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fixtures
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// DefaultImageId is the image of VMs.
+const DefaultImageId = "ami-0f1e2d3c"
+
+// rootDeviceName is the device name of root volumes.
+const rootDeviceName = "/dev/sda1"
+
+// VmBuilder builds a VM.
+type VmBuilder struct {
+	f       *Factory
+	vm      osc.Vm
+	subnet  *osc.Subnet
+	volumes []volumeLink
+}
+
+type volumeLink struct {
+	vol    *osc.Volume
+	device string
+}
+
+// Vm returns a builder of a running VM, outside Nets.
+func (f *Factory) Vm() *VmBuilder {
+	return &VmBuilder{f: f, vm: osc.Vm{
+		VmId:                        f.id("i"),
+		ReservationId:               f.id("r"),
+		CreationDate:                f.date(),
+		ImageId:                     DefaultImageId,
+		VmType:                      DefaultVmType,
+		State:                       osc.VmStateRunning,
+		Placement:                   osc.Placement{SubregionName: f.Subregion, Tenancy: "default"},
+		Architecture:                "x86_64",
+		Hypervisor:                  "xen",
+		Performance:                 "medium",
+		RootDeviceName:              rootDeviceName,
+		RootDeviceType:              "ebs",
+		VmInitiatedShutdownBehavior: "stop",
+		IsSourceDestChecked:         ptr.To(true),
+		BlockDeviceMappings:         []osc.BlockDeviceMappingCreated{},
+		Nics:                        []osc.NicLight{},
+		SecurityGroups:              []osc.SecurityGroupLight{},
+		ProductCodes:                []string{"0001"},
+		Tags:                        []osc.ResourceTag{},
+	}}
+}
+
+// In sets the Subnet of the VM, and its subregion. The VM gets a primary NIC having the next private IP of the Subnet.
+func (b *VmBuilder) In(subnet osc.Subnet) *VmBuilder {
+	b.subnet = &subnet
+	b.vm.NetId, b.vm.SubnetId = ptr.To(subnet.NetId), ptr.To(subnet.SubnetId)
+	b.vm.Placement.SubregionName = subnet.SubregionName
+	return b
+}
+
+// Subregion sets the subregion of the VM.
+func (b *VmBuilder) Subregion(subregion string) *VmBuilder {
+	b.vm.Placement.SubregionName = subregion
+	return b
+}
+
+// State sets the state of the VM.
+func (b *VmBuilder) State(state osc.VmState) *VmBuilder {
+	b.vm.State = state
+	return b
+}
+
+// Type sets the type of the VM.
+func (b *VmBuilder) Type(vmType string) *VmBuilder {
+	b.vm.VmType = vmType
+	return b
+}
+
+// Image sets the image of the VM.
+func (b *VmBuilder) Image(imageId string) *VmBuilder {
+	b.vm.ImageId = imageId
+	return b
+}
+
+// Keypair sets the keypair of the VM.
+func (b *VmBuilder) Keypair(name string) *VmBuilder {
+	b.vm.KeypairName = &name
+	return b
+}
+
+// SecurityGroups sets the security groups of the VM.
+func (b *VmBuilder) SecurityGroups(sgs ...osc.SecurityGroup) *VmBuilder {
+	b.vm.SecurityGroups = securityGroupsLight(sgs)
+	return b
+}
+
+// Volume links a volume to the VM, as device (e.g. /dev/xvdb, or /dev/sda1 for the root volume).
+// vol is updated to be linked to the VM when building the VM.
+func (b *VmBuilder) Volume(vol *osc.Volume, device string) *VmBuilder {
+	b.volumes = append(b.volumes, volumeLink{vol: vol, device: device})
+	return b
+}
+
+// Tag sets a tag.
+func (b *VmBuilder) Tag(key, value string) *VmBuilder {
+	b.vm.Tags = setTag(b.vm.Tags, key, value)
+	return b
+}
+
+// Build returns the VM, and links its volumes. VMs in a Subnet get a primary NIC, having the security groups of the VM.
+func (b *VmBuilder) Build() osc.Vm {
+	switch {
+	case b.subnet != nil && !slices.ContainsFunc(b.vm.Nics, func(n osc.NicLight) bool { return n.LinkNic != nil && n.LinkNic.DeviceNumber == 0 }):
+		nic := b.f.Nic(*b.subnet).Description("Primary network interface")
+		nic.nic.SecurityGroups = slices.Clone(b.vm.SecurityGroups)
+		nic.Link(&b.vm, 0).Build()
+	case b.subnet == nil && b.vm.PrivateIp == "":
+		b.vm.PrivateIp = b.f.privateIp(nil)
+		b.vm.PrivateDnsName = ptr.To(b.f.privateDnsName(b.vm.PrivateIp))
+	}
+	for _, l := range b.volumes {
+		root := l.device == b.vm.RootDeviceName
+		b.vm.BlockDeviceMappings = append(b.vm.BlockDeviceMappings, osc.BlockDeviceMappingCreated{
+			DeviceName: l.device,
+			Bsu: osc.BsuCreated{
+				VolumeId:           l.vol.VolumeId,
+				State:              osc.LinkedVolumeStateAttached,
+				LinkDate:           b.vm.CreationDate,
+				DeleteOnVmDeletion: root,
+			},
+		})
+		l.vol.State = osc.VolumeStateInUse
+		l.vol.SubregionName = b.vm.Placement.SubregionName
+		l.vol.LinkedVolumes = append(l.vol.LinkedVolumes, osc.LinkedVolume{
+			VmId:               b.vm.VmId,
+			VolumeId:           l.vol.VolumeId,
+			DeviceName:         l.device,
+			State:              osc.LinkedVolumeStateAttached,
+			DeleteOnVmDeletion: root,
+		})
+	}
+	// volumes are linked once, if Build is called again.
+	b.volumes = nil
+	vm := b.vm
+	vm.BlockDeviceMappings = slices.Clone(vm.BlockDeviceMappings)
+	vm.Nics = slices.Clone(vm.Nics)
+	vm.Tags = slices.Clone(vm.Tags)
+	return vm
+}
+
+// PublicIpBuilder builds a public IP.
+type PublicIpBuilder struct {
+	f      *Factory
+	ip     osc.PublicIp
+	vm     *osc.Vm
+	linkId string
+}
+
+// PublicIp returns a builder of an unlinked public IP.
+func (f *Factory) PublicIp() *PublicIpBuilder {
+	return &PublicIpBuilder{f: f, ip: osc.PublicIp{
+		PublicIpId: f.id("eipalloc"),
+		PublicIp:   f.publicIp(),
+		Tags:       []osc.ResourceTag{},
+	}}
+}
+
+// Link links the public IP to a VM, and its primary NIC if any. vm is updated when building the public IP.
+func (b *PublicIpBuilder) Link(vm *osc.Vm) *PublicIpBuilder {
+	b.vm, b.linkId = vm, b.f.id("eipassoc")
+	return b
+}
+
+// Tag sets a tag.
+func (b *PublicIpBuilder) Tag(key, value string) *PublicIpBuilder {
+	b.ip.Tags = setTag(b.ip.Tags, key, value)
+	return b
+}
+
+// Build returns the public IP, and links it to its VM.
+func (b *PublicIpBuilder) Build() osc.PublicIp {
+	ip := b.ip
+	ip.Tags = slices.Clone(ip.Tags)
+	if b.vm == nil {
+		return ip
+	}
+	ip.LinkPublicIpId = ptr.To(b.linkId)
+	ip.VmId = ptr.To(b.vm.VmId)
+	ip.PrivateIp = ptr.To(b.vm.PrivateIp)
+	dns := "ows-" + strings.ReplaceAll(ip.PublicIp, ".", "-") + "." + b.f.region() + ".compute.outscale.com"
+	b.vm.PublicIp, b.vm.PublicDnsName = ptr.To(ip.PublicIp), ptr.To(dns)
+	for i := range b.vm.Nics {
+		nic := &b.vm.Nics[i]
+		if nic.LinkNic == nil || nic.LinkNic.DeviceNumber != 0 {
+			continue
+		}
+		ip.NicId, ip.NicAccountId = ptr.To(nic.NicId), ptr.To(nic.AccountId)
+		link := &osc.LinkPublicIpLightForVm{PublicIp: ip.PublicIp, PublicDnsName: dns, PublicIpAccountId: b.f.AccountId}
+		nic.LinkPublicIp = link
+		for j := range nic.PrivateIps {
+			if nic.PrivateIps[j].IsPrimary {
+				nic.PrivateIps[j].LinkPublicIp = link
+			}
+		}
+	}
+	return ip
+}
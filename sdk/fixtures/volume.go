@@ -0,0 +1,126 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package fixtures
+
+import (
+	"slices"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// VolumeBuilder builds a volume.
+type VolumeBuilder struct {
+	vol osc.Volume
+}
+
+// Volume returns a builder of an available, unlinked, 10 GiB gp2 volume.
+func (f *Factory) Volume() *VolumeBuilder {
+	return &VolumeBuilder{vol: osc.Volume{
+		VolumeId:      f.id("vol"),
+		CreationDate:  f.date(),
+		Size:          10,
+		VolumeType:    osc.VolumeTypeGp2,
+		State:         osc.VolumeStateAvailable,
+		SubregionName: f.Subregion,
+		LinkedVolumes: []osc.LinkedVolume{},
+		Tags:          []osc.ResourceTag{},
+	}}
+}
+
+// Size sets the size of the volume, in GiB.
+func (b *VolumeBuilder) Size(size int) *VolumeBuilder {
+	b.vol.Size = size
+	return b
+}
+
+// Type sets the type of the volume, and its IOPS for io1 volumes.
+func (b *VolumeBuilder) Type(volType osc.VolumeType, iops int) *VolumeBuilder {
+	b.vol.VolumeType, b.vol.Iops = volType, iops
+	return b
+}
+
+// State sets the state of the volume.
+func (b *VolumeBuilder) State(state osc.VolumeState) *VolumeBuilder {
+	b.vol.State = state
+	return b
+}
+
+// Subregion sets the subregion of the volume.
+func (b *VolumeBuilder) Subregion(subregion string) *VolumeBuilder {
+	b.vol.SubregionName = subregion
+	return b
+}
+
+// FromSnapshot sets the snapshot the volume is created from, and its size.
+func (b *VolumeBuilder) FromSnapshot(snap osc.Snapshot) *VolumeBuilder {
+	b.vol.SnapshotId, b.vol.Size = ptr.To(snap.SnapshotId), snap.VolumeSize
+	return b
+}
+
+// Tag sets a tag.
+func (b *VolumeBuilder) Tag(key, value string) *VolumeBuilder {
+	b.vol.Tags = setTag(b.vol.Tags, key, value)
+	return b
+}
+
+// Build returns the volume.
+func (b *VolumeBuilder) Build() osc.Volume {
+	vol := b.vol
+	vol.Tags = slices.Clone(vol.Tags)
+	return vol
+}
+
+// SnapshotBuilder builds a snapshot.
+type SnapshotBuilder struct {
+	snap osc.Snapshot
+}
+
+// Snapshot returns a builder of a completed, private snapshot of a volume.
+func (f *Factory) Snapshot(vol osc.Volume) *SnapshotBuilder {
+	return &SnapshotBuilder{snap: osc.Snapshot{
+		SnapshotId:                f.id("snap"),
+		CreationDate:              f.date(),
+		AccountId:                 f.AccountId,
+		VolumeId:                  vol.VolumeId,
+		VolumeSize:                vol.Size,
+		State:                     osc.SnapshotStateCompleted,
+		Progress:                  ptr.To(100),
+		Description:               ptr.To(""),
+		PermissionsToCreateVolume: &osc.PermissionsOnResource{AccountIds: &[]string{}, GlobalPermission: ptr.To(false)},
+		Tags:                      &[]osc.ResourceTag{},
+	}}
+}
+
+// State sets the state of the snapshot, its progress being 100% for completed snapshots, and 0% otherwise.
+func (b *SnapshotBuilder) State(state osc.SnapshotState) *SnapshotBuilder {
+	b.snap.State = state
+	if state == osc.SnapshotStateCompleted {
+		b.snap.Progress = ptr.To(100)
+	} else {
+		b.snap.Progress = ptr.To(0)
+	}
+	return b
+}
+
+// Description sets the description of the snapshot.
+func (b *SnapshotBuilder) Description(desc string) *SnapshotBuilder {
+	b.snap.Description = &desc
+	return b
+}
+
+// Tag sets a tag.
+func (b *SnapshotBuilder) Tag(key, value string) *SnapshotBuilder {
+	b.snap.Tags = ptr.To(setTag(*b.snap.Tags, key, value))
+	return b
+}
+
+// Build returns the snapshot.
+func (b *SnapshotBuilder) Build() osc.Snapshot {
+	snap := b.snap
+	snap.Tags = ptr.To(slices.Clone(*snap.Tags))
+	return snap
+}
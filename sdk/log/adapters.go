@@ -0,0 +1,65 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package log
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+)
+
+// FromSlog returns a logger writing to a slog logger.
+// Verbosity levels are mapped to slog levels as logr does: V(n) logs at slog.Level(-n).
+func FromSlog(l *slog.Logger) Logger {
+	return slogLogger{l: l}
+}
+
+type slogLogger struct {
+	l     *slog.Logger
+	level int
+}
+
+func (s slogLogger) Info(ctx context.Context, msg string, kv ...any) {
+	s.l.Log(ctx, slog.Level(-s.level), msg, kv...)
+}
+
+func (s slogLogger) Error(ctx context.Context, err error, msg string, kv ...any) {
+	s.l.Log(ctx, slog.LevelError, msg, append([]any{"err", err}, kv...)...)
+}
+
+func (s slogLogger) V(level int) Logger {
+	return slogLogger{l: s.l, level: s.level + level}
+}
+
+func (s slogLogger) With(kv ...any) Logger {
+	return slogLogger{l: s.l.With(kv...), level: s.level}
+}
+
+// FromLogr returns a logger writing to a logr logger.
+func FromLogr(l logr.Logger) Logger {
+	return logrLogger{l: l.WithCallDepth(1)}
+}
+
+type logrLogger struct {
+	l logr.Logger
+}
+
+func (l logrLogger) Info(_ context.Context, msg string, kv ...any) {
+	l.l.Info(msg, kv...)
+}
+
+func (l logrLogger) Error(_ context.Context, err error, msg string, kv ...any) {
+	l.l.Error(err, msg, kv...)
+}
+
+func (l logrLogger) V(level int) Logger {
+	return logrLogger{l: l.l.V(level)}
+}
+
+func (l logrLogger) With(kv ...any) Logger {
+	return logrLogger{l: l.l.WithValues(kv...)}
+}
@@ -0,0 +1,64 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package log_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/outscale/goutils/sdk/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSlog(t *testing.T) {
+	var buf bytes.Buffer
+	l := log.FromSlog(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.Level(-4),
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+	t.Run("Verbosity levels are mapped to slog levels", func(t *testing.T) {
+		buf.Reset()
+		l.V(2).V(2).Info(t.Context(), "foo")
+		l.V(5).Info(t.Context(), "bar")
+		assert.Equal(t, "level=DEBUG msg=foo\n", buf.String())
+	})
+	t.Run("Errors are logged whatever the verbosity", func(t *testing.T) {
+		buf.Reset()
+		l.V(10).With("id", "vol-foo").Error(t.Context(), errors.New("bar"), "foo", "count", 1)
+		assert.Equal(t, "level=ERROR msg=foo id=vol-foo err=bar count=1\n", buf.String())
+	})
+}
+
+func TestFromLogr(t *testing.T) {
+	var lines []string
+	l := log.FromLogr(funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: 4}))
+	t.Run("Verbosity levels and values are forwarded", func(t *testing.T) {
+		lines = nil
+		l.With("id", "vol-foo").V(4).Info(t.Context(), "foo", "count", 1)
+		l.V(5).Info(t.Context(), "bar")
+		assert.Equal(t, []string{`"level"=4 "msg"="foo" "id"="vol-foo" "count"=1`}, lines)
+	})
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("The logger of the context is returned", func(t *testing.T) {
+		l := log.Discard().With("foo", "bar")
+		assert.Equal(t, l, log.FromContext(log.NewContext(t.Context(), l)))
+	})
+	t.Run("Default is returned if the context has no logger", func(t *testing.T) {
+		assert.Equal(t, log.Default, log.FromContext(t.Context())) //nolint:staticcheck
+	})
+}
@@ -7,14 +7,45 @@ package log
 
 import "context"
 
+// Default is the logger returned by FromContext when the context has no logger.
+//
+// Deprecated: set loggers in contexts with NewContext, or using component options.
 var Default Logger = noLogger{}
 
+// Logger is the logger used by SDK utilities.
 type Logger interface {
 	Info(ctx context.Context, msg string, kv ...any)
 	Error(ctx context.Context, err error, msg string, kv ...any)
+	// V returns a logger for a verbosity level. Higher levels are more verbose, 0 being the default level.
+	// Errors are logged whatever the verbosity.
+	V(level int) Logger
+	// With returns a logger adding key/values to all messages.
+	With(kv ...any) Logger
 }
 
 type noLogger struct{}
 
 func (noLogger) Info(ctx context.Context, msg string, kv ...any)             {}
 func (noLogger) Error(ctx context.Context, err error, msg string, kv ...any) {}
+func (l noLogger) V(level int) Logger                                        { return l }
+func (l noLogger) With(kv ...any) Logger                                     { return l }
+
+// Discard returns a logger discarding all messages.
+func Discard() Logger {
+	return noLogger{}
+}
+
+type contextKey struct{}
+
+// NewContext returns a context embedding a logger.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger of a context, or Default if none is found.
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(contextKey{}).(Logger); ok {
+		return l
+	}
+	return Default
+}
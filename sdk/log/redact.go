@@ -0,0 +1,110 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+)
+
+// Redacted replaces the value of redacted fields.
+const Redacted = "[redacted]"
+
+// Redactor redacts secret fields from OAPI JSON request and response bodies.
+// Fields are matched by name, at any depth.
+type Redactor struct {
+	// Fields are redacted in all calls.
+	Fields []string
+	// CallFields are redacted in specific calls, by call name.
+	CallFields map[string][]string
+}
+
+// DefaultRedactor is the default deny-list of secret fields.
+var DefaultRedactor = &Redactor{
+	// CreateAccessKey/ReadSecretAccessKey, ReadAdminPassword, CreateKeypair, VPN connections, EIM users.
+	Fields: []string{"SecretKey", "AdminPassword", "PrivateKey", "PreSharedKey", "Password"},
+	CallFields: map[string][]string{
+		"CreateVms":                 {"UserData"},
+		"UpdateVm":                  {"UserData"},
+		"ReadVms":                   {"UserData"},
+		"CreateDirectLinkInterface": {"BgpKey"},
+		"ReadDirectLinkInterfaces":  {"BgpKey"},
+		// the configuration includes the pre-shared keys of the VPN tunnels.
+		"CreateVpnConnection": {"ClientGatewayConfiguration"},
+		"ReadVpnConnections":  {"ClientGatewayConfiguration"},
+	},
+}
+
+// With returns a copy of the redactor, with additional fields redacted in all calls.
+func (r *Redactor) With(fields ...string) *Redactor {
+	return &Redactor{
+		Fields:     slices.Concat(r.Fields, fields),
+		CallFields: maps.Clone(r.CallFields),
+	}
+}
+
+func (r *Redactor) fields(call string) map[string]struct{} {
+	res := map[string]struct{}{}
+	for _, f := range r.Fields {
+		res[f] = struct{}{}
+	}
+	for _, f := range r.CallFields[call] {
+		res[f] = struct{}{}
+	}
+	return res
+}
+
+// Redact returns a body where the values of redacted fields are replaced by Redacted.
+// Bodies that cannot be decoded as a single JSON value are entirely replaced by Redacted.
+func (r *Redactor) Redact(call string, body []byte) []byte {
+	if r == nil || len(body) == 0 {
+		return body
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return []byte(Redacted)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return []byte(Redacted)
+	}
+	if !redact(v, r.fields(call)) {
+		return body
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return []byte(Redacted)
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+// redact redacts v in place, and returns true if a field has been redacted.
+func redact(v any, fields map[string]struct{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]any:
+		for k, sub := range v {
+			if _, found := fields[k]; found {
+				v[k] = Redacted
+				redacted = true
+				continue
+			}
+			redacted = redact(sub, fields) || redacted
+		}
+	case []any:
+		for _, sub := range v {
+			redacted = redact(sub, fields) || redacted
+		}
+	}
+	return redacted
+}
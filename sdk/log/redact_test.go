@@ -0,0 +1,44 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package log_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/sdk/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	t.Run("Secret fields are redacted at any depth", func(t *testing.T) {
+		body := `{"AccessKey":{"AccessKeyId":"AK","SecretKey":"SK","State":"ACTIVE"},"ResponseContext":{"RequestId":"foo"}}`
+		assert.JSONEq(t,
+			`{"AccessKey":{"AccessKeyId":"AK","SecretKey":"[redacted]","State":"ACTIVE"},"ResponseContext":{"RequestId":"foo"}}`,
+			string(log.DefaultRedactor.Redact("CreateAccessKey", []byte(body))))
+	})
+	t.Run("Call fields are only redacted in their calls", func(t *testing.T) {
+		body := `{"Vms":[{"VmId":"i-foo","UserData":"c2VjcmV0"}],"Count":1.50}`
+		assert.JSONEq(t, `{"Vms":[{"VmId":"i-foo","UserData":"[redacted]"}],"Count":1.50}`,
+			string(log.DefaultRedactor.Redact("ReadVms", []byte(body))))
+		assert.Equal(t, body, string(log.DefaultRedactor.Redact("ReadVolumes", []byte(body))))
+	})
+	t.Run("Additional fields are redacted", func(t *testing.T) {
+		r := log.DefaultRedactor.With("Description")
+		assert.JSONEq(t, `{"Description":"[redacted]","SecretKey":"[redacted]"}`,
+			string(r.Redact("CreateNet", []byte(`{"Description":"<foo>","SecretKey":"SK"}`))))
+		assert.NotContains(t, log.DefaultRedactor.Fields, "Description")
+	})
+	t.Run("VPN configurations are redacted", func(t *testing.T) {
+		body := `{"VpnConnections":[{"VpnConnectionId":"vpn-foo","ClientGatewayConfiguration":"<pre_shared_key>secret</pre_shared_key>"}]}`
+		assert.JSONEq(t, `{"VpnConnections":[{"VpnConnectionId":"vpn-foo","ClientGatewayConfiguration":"[redacted]"}]}`,
+			string(log.DefaultRedactor.Redact("ReadVpnConnections", []byte(body))))
+	})
+	t.Run("Bodies that cannot be decoded are redacted", func(t *testing.T) {
+		assert.Equal(t, log.Redacted, string(log.DefaultRedactor.Redact("CreateVms", []byte("<html>"))))
+		assert.Equal(t, log.Redacted, string(log.DefaultRedactor.Redact("CreateVms", []byte(`{"SecretKey":"SK"`))))
+		assert.Equal(t, log.Redacted, string(log.DefaultRedactor.Redact("CreateVms", []byte(`{} {"SecretKey":"SK"}`))))
+	})
+}
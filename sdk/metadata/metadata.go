@@ -13,6 +13,8 @@ import (
 	"net/http"
 	"path"
 	"strings"
+
+	"github.com/outscale/goutils/sdk/log"
 )
 
 var DefaultService = NewService(http.DefaultClient)
@@ -64,16 +66,39 @@ type Metadata struct {
 // Service is a metadata service.
 type Service struct {
 	client *http.Client
+	logger log.Logger
+}
+
+// Option configures a metadata service.
+type Option func(s *Service)
+
+// WithLogger sets the logger of a metadata service. The logger of the context is used by default.
+func WithLogger(l log.Logger) Option {
+	return func(s *Service) {
+		s.logger = l
+	}
 }
 
 // NewService builds a metadata service.
-func NewService(client *http.Client) *Service {
-	return &Service{
+func NewService(client *http.Client, opts ...Option) *Service {
+	s := &Service{
 		client: client,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) loggerFor(ctx context.Context) log.Logger {
+	if s.logger != nil {
+		return s.logger
+	}
+	return log.FromContext(ctx)
 }
 
 func (s *Service) fetch(ctx context.Context, path string) (res string, err error) {
+	s.loggerFor(ctx).V(5).Info(ctx, "Fetching metadata", "path", path)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, MetadataServer+path, nil)
 	if err != nil {
 		return "", fmt.Errorf("get metadata: %w", err)
@@ -48,3 +48,16 @@ func MockDevideMappings(mappings map[string]string) {
 	}
 	mock(metadata.DeviceMapping, strings.Join(lo.Keys(mappings), "\n"))
 }
+
+func MockPlacement(p metadata.Placement) {
+	mock(metadata.Subregion, p.Subregion)
+	mock(metadata.PlacementCluster, p.Cluster)
+	mock(metadata.PlacementServer, p.Server)
+}
+
+func MockTags(tags map[string]string) {
+	for k, v := range tags {
+		mock(path.Join(metadata.Tags, k), v)
+	}
+	mock(metadata.Tags, strings.Join(lo.Keys(tags), "\n"))
+}
@@ -0,0 +1,246 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package mocks_osc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"go.uber.org/mock/gomock"
+)
+
+// oscPkg is the package path of OAPI types, whose structs are compared field by field.
+var oscPkg = reflect.TypeFor[osc.Vm]().PkgPath()
+
+// MatchOption configures a matcher.
+type MatchOption func(*Matcher)
+
+// Fields restricts matching to some fields, by dot-separated path (e.g. Filters.VolumeIds).
+// It panics if a path is not found in the expected type.
+func Fields(paths ...string) MatchOption {
+	return func(m *Matcher) {
+		for _, p := range paths {
+			path := strings.Split(p, ".")
+			if err := checkPath(m.want.Type(), path); err != nil {
+				panic(fmt.Sprintf("mocks_osc.Fields: %v", err))
+			}
+			m.fields = append(m.fields, path)
+		}
+	}
+}
+
+// Matcher matches OAPI requests, e.g. osc.Read*Request and osc.Filters* structs, semantically:
+//   - slice fields of osc.Filters* structs are matched as sets, the order of filter values being meaningless,
+//   - nil pointers match pointers to empty slices or to empty structs (e.g. an empty filter),
+//   - slices are matched by content, nil slices matching empty slices.
+//
+// Mismatches are reported with the list of differing fields.
+type Matcher struct {
+	want   reflect.Value
+	fields [][]string
+}
+
+var (
+	_ gomock.Matcher      = (*Matcher)(nil)
+	_ gomock.GotFormatter = (*Matcher)(nil)
+)
+
+// Eq returns a matcher of values semantically equal to want.
+func Eq[T any](want T, opts ...MatchOption) *Matcher {
+	m := &Matcher{want: reflect.ValueOf(any(want))}
+	if !m.want.IsValid() {
+		m.want = reflect.ValueOf(&want).Elem()
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+func (m *Matcher) Matches(x any) bool {
+	return len(m.diff(x)) == 0
+}
+
+func (m *Matcher) String() string {
+	s := "is semantically equal to " + format(m.want.Interface())
+	if len(m.fields) > 0 {
+		var paths []string
+		for _, p := range m.fields {
+			paths = append(paths, strings.Join(p, "."))
+		}
+		s += " on fields " + strings.Join(paths, ", ")
+	}
+	return s
+}
+
+func (m *Matcher) Got(got any) string {
+	diffs := m.diff(got)
+	if len(diffs) == 0 {
+		return format(got)
+	}
+	return format(got) + "\nDiff:\n\t" + strings.Join(diffs, "\n\t")
+}
+
+func (m *Matcher) diff(x any) []string {
+	got := reflect.ValueOf(x)
+	if !got.IsValid() || got.Type() != m.want.Type() {
+		return []string{fmt.Sprintf("got type %T, want %s", x, m.want.Type())}
+	}
+	if len(m.fields) == 0 {
+		return diff("", m.want, got, false)
+	}
+	var diffs []string
+	for _, path := range m.fields {
+		want, got, set := m.want, got, false
+		for _, name := range path {
+			want, got = deref(want), deref(got)
+			set = isFilters(want.Type())
+			want, got = want.FieldByName(name), got.FieldByName(name)
+		}
+		diffs = append(diffs, diff(strings.Join(path, "."), want, got, set)...)
+	}
+	return diffs
+}
+
+// diff returns the differences between two values of the same type, as "path: want X, got Y".
+func diff(path string, want, got reflect.Value, set bool) []string {
+	if want.Kind() == reflect.Pointer {
+		switch {
+		case isEmpty(want) && isEmpty(got):
+			return nil
+		case want.IsNil() != got.IsNil():
+			return []string{mismatch(path, want, got)}
+		}
+		want, got = want.Elem(), got.Elem()
+	}
+	switch {
+	case want.Kind() == reflect.Struct && want.Type().PkgPath() == oscPkg:
+		var diffs []string
+		set = isFilters(want.Type())
+		for i := range want.NumField() {
+			if !want.Type().Field(i).IsExported() {
+				continue
+			}
+			diffs = append(diffs, diff(join(path, want.Type().Field(i).Name), want.Field(i), got.Field(i), set)...)
+		}
+		return diffs
+	case want.Kind() == reflect.Slice && set:
+		if !sameSet(want, got) {
+			return []string{mismatch(path, want, got) + " (as sets)"}
+		}
+		return nil
+	case want.Kind() == reflect.Slice:
+		if want.Len() != got.Len() {
+			return []string{mismatch(path, want, got)}
+		}
+		var diffs []string
+		for i := range want.Len() {
+			diffs = append(diffs, diff(fmt.Sprintf("%s[%d]", path, i), want.Index(i), got.Index(i), false)...)
+		}
+		return diffs
+	case !reflect.DeepEqual(want.Interface(), got.Interface()):
+		return []string{mismatch(path, want, got)}
+	}
+	return nil
+}
+
+// isFilters checks if a type is an osc.Filters* struct, whose slice fields are matched as sets.
+func isFilters(t reflect.Type) bool {
+	return t.PkgPath() == oscPkg && strings.HasPrefix(t.Name(), "Filters")
+}
+
+// isEmpty checks if a pointer is nil, or points to an empty slice, map or struct.
+func isEmpty(v reflect.Value) bool {
+	if v.IsNil() {
+		return true
+	}
+	switch v.Elem().Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Elem().Len() == 0
+	case reflect.Struct:
+		return v.Elem().IsZero()
+	default:
+		return false
+	}
+}
+
+// deref dereferences pointers, nil pointers being replaced by zero values.
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Zero(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// sameSet checks if two slices have the same elements, ignoring order and duplicates.
+func sameSet(a, b reflect.Value) bool {
+	contains := func(s reflect.Value, v reflect.Value) bool {
+		for i := range s.Len() {
+			if reflect.DeepEqual(s.Index(i).Interface(), v.Interface()) {
+				return true
+			}
+		}
+		return false
+	}
+	for i := range a.Len() {
+		if !contains(b, a.Index(i)) {
+			return false
+		}
+	}
+	for i := range b.Len() {
+		if !contains(a, b.Index(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPath checks that a field path exists in a type.
+func checkPath(t reflect.Type, path []string) error {
+	for i, name := range path {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("%s is not a struct", strings.Join(path[:i], "."))
+		}
+		f, found := t.FieldByName(name)
+		if !found || !f.IsExported() {
+			return fmt.Errorf("unknown field %s in %s", strings.Join(path[:i+1], "."), t)
+		}
+		t = f.Type
+	}
+	return nil
+}
+
+func mismatch(path string, want, got reflect.Value) string {
+	if path == "" {
+		path = "value"
+	}
+	return fmt.Sprintf("%s: want %s, got %s", path, format(want.Interface()), format(got.Interface()))
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// format formats values as JSON, for readability, and with %v if they cannot be encoded.
+func format(v any) string {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(buf)
+}
@@ -0,0 +1,103 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package mocks_osc_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEq(t *testing.T) {
+	want := osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{
+		VolumeIds: &[]string{"vol-a", "vol-b"},
+		TagKeys:   &[]string{"foo"},
+	}}
+	t.Run("Filters are matched as sets", func(t *testing.T) {
+		m := mocks_osc.Eq(want)
+		assert.True(t, m.Matches(osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{
+			VolumeIds: &[]string{"vol-b", "vol-a", "vol-a"},
+			TagKeys:   &[]string{"foo"},
+		}}))
+		assert.False(t, m.Matches(osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{
+			VolumeIds: &[]string{"vol-b", "vol-c"},
+			TagKeys:   &[]string{"foo"},
+		}}))
+	})
+	t.Run("Nil and empty pointers are equal", func(t *testing.T) {
+		assert.True(t, mocks_osc.Eq(osc.ReadVolumesRequest{}).Matches(osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{}}))
+		assert.True(t, mocks_osc.Eq(want).Matches(osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{
+			VolumeIds:   &[]string{"vol-a", "vol-b"},
+			TagKeys:     &[]string{"foo"},
+			TagValues:   &[]string{},
+			SnapshotIds: nil,
+		}}))
+		assert.False(t, mocks_osc.Eq(osc.ReadVmsRequest{}).Matches(osc.ReadVmsRequest{DryRun: ptr.To(false)}),
+			"nil and false booleans are not equal")
+	})
+	t.Run("Other slices are ordered", func(t *testing.T) {
+		m := mocks_osc.Eq(osc.CreateTagsRequest{ResourceIds: []string{"vol-a", "vol-b"}})
+		assert.True(t, m.Matches(osc.CreateTagsRequest{ResourceIds: []string{"vol-a", "vol-b"}, Tags: []osc.ResourceTag{}}))
+		assert.False(t, m.Matches(osc.CreateTagsRequest{ResourceIds: []string{"vol-b", "vol-a"}}))
+	})
+	t.Run("Only chosen fields are checked", func(t *testing.T) {
+		m := mocks_osc.Eq(want, mocks_osc.Fields("Filters.VolumeIds"))
+		assert.True(t, m.Matches(osc.ReadVolumesRequest{
+			Filters:        &osc.FiltersVolume{VolumeIds: &[]string{"vol-b", "vol-a"}},
+			ResultsPerPage: ptr.To(10),
+		}))
+		assert.False(t, m.Matches(osc.ReadVolumesRequest{}))
+		assert.Panics(t, func() { mocks_osc.Eq(want, mocks_osc.Fields("Filters.Foo")) })
+	})
+	t.Run("Mismatches are reported as diffs", func(t *testing.T) {
+		m := mocks_osc.Eq(want)
+		got := m.Got(osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{VolumeIds: &[]string{"vol-a"}, TagKeys: &[]string{"foo"}}})
+		assert.Contains(t, got, `Filters.VolumeIds: want ["vol-a","vol-b"], got ["vol-a"] (as sets)`)
+		_, diff, _ := strings.Cut(got, "Diff:")
+		assert.NotContains(t, diff, "TagKeys")
+		assert.Contains(t, m.Got(osc.ReadVmsRequest{}), "got type osc.ReadVmsRequest")
+	})
+	t.Run("Matchers are used with mocks", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadVolumes(gomock.Any(), mocks_osc.Eq(want)).Return(&osc.ReadVolumesResponse{}, nil)
+		_, err := mockSDK.ReadVolumes(t.Context(), osc.ReadVolumesRequest{Filters: &osc.FiltersVolume{
+			VolumeIds: &[]string{"vol-b", "vol-a"},
+			TagKeys:   &[]string{"foo"},
+		}})
+		require.NoError(t, err)
+	})
+}
+
+// All Read*Request types are supported, with their Filters* types.
+func TestEq_ReadRequests(t *testing.T) {
+	client := reflect.TypeFor[osc.ClientInterface]()
+	var n int
+	for i := range client.NumMethod() {
+		method := client.Method(i)
+		if !strings.HasPrefix(method.Name, "Read") || method.Type.NumIn() < 2 {
+			continue
+		}
+		req := method.Type.In(1)
+		if req.Kind() != reflect.Struct || !strings.HasSuffix(req.Name(), "Request") {
+			continue
+		}
+		n++
+		empty := reflect.New(req).Elem()
+		if f := empty.FieldByName("Filters"); f.IsValid() && f.Kind() == reflect.Pointer {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		assert.True(t, mocks_osc.Eq(reflect.Zero(req).Interface()).Matches(empty.Interface()), req.Name())
+	}
+	assert.Greater(t, n, 50)
+}
@@ -0,0 +1,55 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package oapitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+)
+
+// FromClient returns a backend serving calls with the methods of an OAPI client, e.g. a fake_osc.Client or a mock.
+// Requests are decoded into the request type of the method having the name of the call, and ErrInvalidRequest is
+// returned for requests that cannot be decoded.
+func FromClient(client osc.ClientInterface) Backend {
+	v := reflect.ValueOf(client)
+	return BackendFunc(func(ctx context.Context, call string, body []byte) (res any, err error) {
+		m := v.MethodByName(call)
+		if !m.IsValid() || !isCallMethod(m.Type()) {
+			return nil, fmt.Errorf("%w %q", ErrUnknownCall, call)
+		}
+		req := reflect.New(m.Type().In(1))
+		if err := json.Unmarshal(body, req.Interface()); err != nil {
+			return nil, fmt.Errorf("%w: decode %s request: %w", ErrInvalidRequest, call, err)
+		}
+		defer func() {
+			// calls not implemented by fakes panic
+			if r := recover(); r != nil {
+				res, err = nil, fmt.Errorf("%s: %v", call, r)
+			}
+		}()
+		out := m.Call([]reflect.Value{reflect.ValueOf(ctx), req.Elem()})
+		if !out[1].IsNil() {
+			return nil, out[1].Interface().(error)
+		}
+		return out[0].Interface(), nil
+	})
+}
+
+// isCallMethod checks that a method has the signature of an OAPI call:
+// func(ctx context.Context, req XRequest, opts ...middleware.MiddlewareChainOption) (*XResponse, error).
+func isCallMethod(t reflect.Type) bool {
+	return t.NumIn() == 3 && t.IsVariadic() && t.In(0) == contextType && t.In(1).Kind() == reflect.Struct &&
+		t.NumOut() == 2 && t.Out(0).Kind() == reflect.Pointer && t.Out(1) == errorType
+}
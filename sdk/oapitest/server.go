@@ -0,0 +1,287 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package oapitest provides an OAPI server for end-to-end tests of OAPI clients, including signing, middlewares,
+// retries and rate limiting.
+package oapitest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/outscale/osc-sdk-go/v3/pkg/profile"
+)
+
+// Credentials and region accepted by the server.
+const (
+	AccessKey = "AKOAPITEST0000000000"
+	SecretKey = "SKOAPITEST0000000000000000000000000000"
+	Region    = "eu-west-2"
+)
+
+// apiPath is the path prefix of OAPI calls.
+const apiPath = "/api/v1/"
+
+// authRe matches the Authorization header of requests signed with AWS signature v4.
+var authRe = regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=([^/]+)/(\d{8})/([^/]+)/osc/aws4_request, SignedHeaders=([a-z0-9;-]+), Signature=[0-9a-f]{64}$`)
+
+// Request is a request received by the server.
+type Request struct {
+	// Call is the name of the OAPI call (e.g. ReadVms).
+	Call   string
+	Header http.Header
+	Body   []byte
+}
+
+// Fault is a fault injected in responses.
+type Fault struct {
+	// Status is the HTTP status returned instead of calling the backend (e.g. 503 or 429).
+	// Faults without status only add latency.
+	Status int
+	// Latency is added before responding.
+	Latency time.Duration
+	// Count is the number of calls affected by the fault, 0 meaning all calls.
+	Count int
+}
+
+type fault struct {
+	Fault
+	call string
+}
+
+// Server is an OAPI server, serving calls from a backend.
+type Server struct {
+	*httptest.Server
+
+	backend Backend
+
+	mu       sync.Mutex
+	faults   []*fault
+	requests []Request
+}
+
+// NewServer starts a server serving calls from a backend. It is closed at the end of the test.
+func NewServer(t testing.TB, backend Backend) *Server {
+	s := &Server{backend: backend}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Profile returns a profile targeting the server.
+func (s *Server) Profile() *profile.Profile {
+	return &profile.Profile{
+		AccessKey: AccessKey,
+		SecretKey: SecretKey,
+		Region:    Region,
+		Endpoints: profile.Endpoint{API: s.URL + strings.TrimSuffix(apiPath, "/")},
+	}
+}
+
+// Setenv sets the OSC_* environment variables to target the server, for the duration of the test.
+func (s *Server) Setenv(t testing.TB) {
+	t.Setenv("OSC_ACCESS_KEY", AccessKey)
+	t.Setenv("OSC_SECRET_KEY", SecretKey)
+	t.Setenv("OSC_REGION", Region)
+	t.Setenv("OSC_ENDPOINT_API", s.Profile().Endpoints.API)
+	t.Setenv("OSC_CONFIG_FILE", "")
+	t.Setenv("OSC_PROFILE", "")
+}
+
+// Inject injects a fault in the responses to a call, or to all calls if call is empty.
+// Faults are applied in injection order, until their count is reached.
+func (s *Server) Inject(call string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{Fault: f, call: call})
+}
+
+// Throttle throttles the next count calls, with a 503 status.
+func (s *Server) Throttle(call string, count int) {
+	s.Inject(call, Fault{Status: http.StatusServiceUnavailable, Count: count})
+}
+
+// Requests returns the requests received by the server, including rejected ones.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// Calls returns the number of requests received for a call.
+func (s *Server) Calls(call string) int {
+	var n int
+	for _, r := range s.Requests() {
+		if r.Call == call {
+			n++
+		}
+	}
+	return n
+}
+
+// fault returns the fault to apply to a call, if any.
+func (s *Server) fault(call string) (Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if f.call != "" && f.call != call {
+			continue
+		}
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			}
+		}
+		return f.Fault, true
+	}
+	return Fault{}, false
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	call, found := strings.CutPrefix(r.URL.Path, apiPath)
+	if !found || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Call: call, Header: r.Header.Clone(), Body: body})
+	s.mu.Unlock()
+
+	if f, found := s.fault(call); found {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return
+		}
+		if f.Status != 0 {
+			writeError(w, f.Status, faultError(f.Status))
+			return
+		}
+	}
+	if err := checkSignature(r); err != nil {
+		writeError(w, http.StatusUnauthorized, osc.Errors{Code: "1", Type: "AccessDenied", Details: err.Error()})
+		return
+	}
+	if !json.Valid(body) {
+		writeError(w, http.StatusBadRequest, osc.Errors{Code: "4045", Type: "InvalidParameterValue", Details: "invalid JSON body"})
+		return
+	}
+	res, err := s.backend.Call(r.Context(), call, body)
+	switch {
+	case errors.Is(err, ErrUnknownCall):
+		writeError(w, http.StatusNotFound, osc.Errors{Code: "4109", Type: "OperationNotSupported", Details: err.Error()})
+	case errors.Is(err, ErrInvalidRequest):
+		writeError(w, http.StatusBadRequest, osc.Errors{Code: "4045", Type: "InvalidParameterValue", Details: err.Error()})
+	case err != nil:
+		writeBackendError(w, err)
+	default:
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// checkSignature checks the format of the AWS signature v4 of a request, and its access key.
+func checkSignature(r *http.Request) error {
+	m := authRe.FindStringSubmatch(r.Header.Get("Authorization"))
+	switch {
+	case m == nil:
+		return errors.New("missing or invalid signature")
+	case m[1] != AccessKey:
+		return fmt.Errorf("unknown access key %q", m[1])
+	case m[3] != Region:
+		return fmt.Errorf("invalid region %q", m[3])
+	case r.Header.Get("X-Amz-Date") == "" || !strings.HasPrefix(r.Header.Get("X-Amz-Date"), m[2]):
+		return errors.New("missing or invalid X-Amz-Date header")
+	case !slices.Contains(strings.Split(m[4], ";"), "host") || !slices.Contains(strings.Split(m[4], ";"), "x-amz-date"):
+		return fmt.Errorf("host and x-amz-date must be signed, got %q", m[4])
+	}
+	return nil
+}
+
+func faultError(status int) osc.Errors {
+	switch status {
+	case http.StatusTooManyRequests:
+		return osc.Errors{Type: "TooManyRequests", Details: "too many requests"}
+	case http.StatusServiceUnavailable:
+		return osc.Errors{Type: "RequestLimitExceeded", Details: "request limit exceeded"}
+	default:
+		return osc.Errors{Code: "2000", Type: "InternalError", Details: http.StatusText(status)}
+	}
+}
+
+// writeBackendError writes an error returned by the backend. OAPI errors are returned with their HTTP status,
+// and other errors as internal errors.
+func writeBackendError(w http.ResponseWriter, err error) {
+	oerr := osc.AsErrorResponse(err)
+	if oerr == nil {
+		writeError(w, http.StatusInternalServerError, osc.Errors{Code: "2000", Type: "InternalError", Details: err.Error()})
+		return
+	}
+	var serr *sdkerrors.Error
+	status := http.StatusBadRequest
+	if errors.As(sdkerrors.Wrap(err), &serr) && serr.HTTPStatus != 0 {
+		status = serr.HTTPStatus
+	}
+	writeJSON(w, status, oerr)
+}
+
+func writeError(w http.ResponseWriter, status int, e osc.Errors) {
+	writeJSON(w, status, osc.ErrorResponse{
+		Errors:          []osc.Errors{e},
+		ResponseContext: &osc.ResponseContext{RequestId: ptr.To("oapitest")},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf)
+}
+
+// Backend serves OAPI calls.
+type Backend interface {
+	// Call serves a call, with the JSON body of the request, and returns the response to encode as JSON.
+	// It returns ErrUnknownCall for unsupported calls, and ErrInvalidRequest for invalid requests.
+	Call(ctx context.Context, call string, body []byte) (any, error)
+}
+
+// BackendFunc is a function serving OAPI calls.
+type BackendFunc func(ctx context.Context, call string, body []byte) (any, error)
+
+func (f BackendFunc) Call(ctx context.Context, call string, body []byte) (any, error) {
+	return f(ctx, call, body)
+}
+
+var (
+	// ErrUnknownCall is returned by backends for unsupported calls.
+	ErrUnknownCall = errors.New("unknown call")
+	// ErrInvalidRequest is returned by backends for requests that cannot be decoded.
+	ErrInvalidRequest = errors.New("invalid request")
+)
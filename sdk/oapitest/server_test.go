@@ -0,0 +1,90 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package oapitest_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/sdk/auth"
+	sdkerrors "github.com/outscale/goutils/sdk/errors"
+	"github.com/outscale/goutils/sdk/fake_osc"
+	"github.com/outscale/goutils/sdk/oapitest"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"github.com/outscale/osc-sdk-go/v3/pkg/options"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, srv *oapitest.Server, opts ...middleware.MiddlewareChainOption) *osc.Client {
+	client, err := osc.NewClient(srv.Profile(), append([]middleware.MiddlewareChainOption{options.WithoutRetry()}, opts...)...)
+	require.NoError(t, err)
+	return client
+}
+
+func TestServer(t *testing.T) {
+	t.Run("Calls are served by the backend", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		client := newClient(t, srv)
+		vol, err := client.CreateVolume(t.Context(), osc.CreateVolumeRequest{SubregionName: "eu-west-2a", Size: ptr.To(10)})
+		require.NoError(t, err)
+		res, err := client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		require.Len(t, *res.Volumes, 1)
+		assert.Equal(t, vol.Volume.VolumeId, (*res.Volumes)[0].VolumeId)
+		assert.Equal(t, 1, srv.Calls("ReadVolumes"))
+		assert.JSONEq(t, `{}`, string(srv.Requests()[1].Body))
+	})
+	t.Run("OAPI errors of the backend are returned", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		_, err := newClient(t, srv).DeleteVolume(t.Context(), osc.DeleteVolumeRequest{VolumeId: "vol-foo"})
+		assert.True(t, osc.IsNotFound(err))
+	})
+	t.Run("Requests that cannot be decoded are rejected as invalid parameters", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		_, err := newClient(t, srv).DeleteVolumeWithBody(t.Context(), "application/json", strings.NewReader(`{"VolumeId":1}`))
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrInvalidParameter))
+	})
+	t.Run("Unsigned requests and unknown access keys are rejected", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		prof := srv.Profile()
+		prof.AccessKey = "foo"
+		client, err := osc.NewClient(prof, options.WithoutRetry())
+		require.NoError(t, err)
+		err = auth.CheckCredentials(t.Context(), client)
+		assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+		assert.NoError(t, auth.CheckCredentials(t.Context(), newClient(t, srv)))
+	})
+	t.Run("Throttled calls are retried", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Throttle("ReadVolumes", 2)
+		client := newClient(t, srv, options.WithRetry(ptr.To(time.Millisecond), ptr.To(time.Millisecond), ptr.To(3)))
+		_, err := client.ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, srv.Calls("ReadVolumes"))
+
+		srv.Inject("", oapitest.Fault{Status: 429, Count: 1})
+		_, err = newClient(t, srv).ReadVolumes(t.Context(), osc.ReadVolumesRequest{})
+		assert.True(t, sdkerrors.Is(err, sdkerrors.ErrThrottled), "throttling errors are returned without retries")
+	})
+	t.Run("Latency is injected", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		srv.Inject("ReadVolumes", oapitest.Fault{Latency: time.Second})
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, err := newClient(t, srv).ReadVolumes(ctx, osc.ReadVolumesRequest{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("Unknown calls are rejected", func(t *testing.T) {
+		srv := oapitest.NewServer(t, oapitest.FromClient(fake_osc.NewClient(fake_osc.Options{})))
+		_, err := newClient(t, srv).ReadImages(t.Context(), osc.ReadImagesRequest{})
+		assert.Error(t, err)
+	})
+}
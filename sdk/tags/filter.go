@@ -0,0 +1,165 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// FiltersType lists all osc.Filters* types having TagKeys/TagValues/Tags fields.
+type FiltersType interface {
+	osc.FiltersClientGateway | osc.FiltersDhcpOptions | osc.FiltersImage | osc.FiltersInternetService |
+		osc.FiltersKeypair | osc.FiltersNatService | osc.FiltersNet | osc.FiltersNetAccessPoint |
+		osc.FiltersNetPeering | osc.FiltersNic | osc.FiltersPublicIp | osc.FiltersRouteTable |
+		osc.FiltersSecurityGroup | osc.FiltersSnapshot | osc.FiltersSubnet | osc.FiltersVirtualGateway |
+		osc.FiltersVm | osc.FiltersVmGroup | osc.FiltersVmTemplate | osc.FiltersVolume | osc.FiltersVpnConnection
+}
+
+type condKind int
+
+const (
+	condEqual condKind = iota
+	condExists
+	condPrefix
+)
+
+type cond struct {
+	kind       condKind
+	key, value string
+}
+
+func (c cond) match(tags []osc.ResourceTag) bool {
+	switch c.kind {
+	case condEqual:
+		return Has(tags, c.key, c.value)
+	case condExists:
+		return Has(tags, c.key)
+	default:
+		_, _, found := HasPrefix(tags, c.key)
+		return found
+	}
+}
+
+// Filter is a tag filter. All conditions of a filter must match.
+// The zero value matches everything.
+//
+// The OAPI filters on tags cannot express all filters:
+//   - TagKeys and TagValues are matched independently (any key with any value), and are never used by Filter,
+//   - multiple values in Tags or TagKeys match any value, and only the first Equal and Exists conditions are sent to the API,
+//   - prefixes cannot be sent to the API.
+//
+// Results need to be post-filtered with Match if ServerSide returns false.
+type Filter struct {
+	conds []cond
+}
+
+// NewFilter returns an empty filter.
+func NewFilter() Filter {
+	return Filter{}
+}
+
+func (f Filter) with(c cond) Filter {
+	return Filter{conds: append(f.conds[:len(f.conds):len(f.conds)], c)}
+}
+
+// Equal adds a condition on a tag having a key with an exact value.
+func (f Filter) Equal(key, value string) Filter {
+	return f.with(cond{kind: condEqual, key: key, value: value})
+}
+
+// Exists adds a condition on a tag key existence.
+func (f Filter) Exists(key string) Filter {
+	return f.with(cond{kind: condExists, key: key})
+}
+
+// Prefix adds a condition on a tag key having a prefix.
+func (f Filter) Prefix(prefix string) Filter {
+	return f.with(cond{kind: condPrefix, key: prefix})
+}
+
+// Match checks if tags match all conditions of the filter.
+func (f Filter) Match(tags []osc.ResourceTag) bool {
+	for _, c := range f.conds {
+		if !c.match(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// ServerSide checks if the filter is fully evaluated by the API.
+func (f Filter) ServerSide() bool {
+	var equal, exists int
+	for _, c := range f.conds {
+		switch c.kind {
+		case condEqual:
+			equal++
+		case condExists:
+			exists++
+		default:
+			return false
+		}
+	}
+	return equal <= 1 && exists <= 1
+}
+
+func (f Filter) render() (tagKeys, tags *[]string) {
+	for _, c := range f.conds {
+		switch {
+		case c.kind == condEqual && tags == nil:
+			tags = &[]string{c.key + "=" + c.value}
+		case c.kind == condExists && tagKeys == nil:
+			tagKeys = &[]string{c.key}
+		}
+	}
+	return tagKeys, tags
+}
+
+// ApplyFilter sets the tag fields of an OAPI filter, replacing any existing TagKeys/TagValues/Tags values.
+func ApplyFilter[F FiltersType](filters *F, f Filter) {
+	tagKeys, tags := f.render()
+	v := reflect.ValueOf(filters).Elem()
+	v.FieldByName("TagKeys").Set(reflect.ValueOf(tagKeys))
+	v.FieldByName("TagValues").Set(reflect.ValueOf((*[]string)(nil)))
+	v.FieldByName("Tags").Set(reflect.ValueOf(tags))
+}
+
+// Filters builds a new OAPI filter from a tag filter.
+func Filters[F FiltersType](f Filter) *F {
+	filters := new(F)
+	ApplyFilter(filters, f)
+	return filters
+}
+
+// Select returns the items whose tags match the filter.
+func Select[T any](items []T, f Filter, tagsOf func(item *T) []osc.ResourceTag) []T {
+	res := make([]T, 0, len(items))
+	for i := range items {
+		if f.Match(tagsOf(&items[i])) {
+			res = append(res, items[i])
+		}
+	}
+	return res
+}
+
+// String returns a text representation of the filter.
+func (f Filter) String() string {
+	strs := make([]string, 0, len(f.conds))
+	for _, c := range f.conds {
+		switch c.kind {
+		case condEqual:
+			strs = append(strs, c.key+"="+c.value)
+		case condExists:
+			strs = append(strs, c.key)
+		default:
+			strs = append(strs, c.key+"*")
+		}
+	}
+	return strings.Join(strs, ",")
+}
@@ -0,0 +1,54 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	t.Run("Equal & Exists conditions are rendered in Tags & TagKeys", func(t *testing.T) {
+		f := tags.NewFilter().Equal("foo", "bar").Exists("baz")
+		assert.True(t, f.ServerSide())
+		assert.Equal(t, &osc.FiltersPublicIp{
+			Tags:    &[]string{"foo=bar"},
+			TagKeys: &[]string{"baz"},
+		}, tags.Filters[osc.FiltersPublicIp](f))
+	})
+	t.Run("ApplyFilter keeps other fields and replaces TagValues", func(t *testing.T) {
+		filters := &osc.FiltersVolume{VolumeIds: &[]string{"vol-foo"}, TagValues: &[]string{"bar"}}
+		tags.ApplyFilter(filters, tags.NewFilter().Equal("foo", "bar"))
+		assert.Equal(t, &osc.FiltersVolume{
+			VolumeIds: &[]string{"vol-foo"},
+			Tags:      &[]string{"foo=bar"},
+		}, filters)
+	})
+	t.Run("Filters that cannot be expressed by the API are not server side", func(t *testing.T) {
+		assert.False(t, tags.NewFilter().Prefix("foo/").ServerSide())
+		assert.False(t, tags.NewFilter().Equal("foo", "bar").Equal("baz", "qux").ServerSide())
+		assert.Equal(t, &osc.FiltersVm{Tags: &[]string{"foo=bar"}},
+			tags.Filters[osc.FiltersVm](tags.NewFilter().Equal("foo", "bar").Equal("baz", "qux").Prefix("foo/")))
+	})
+	t.Run("Match checks all conditions", func(t *testing.T) {
+		tgs := []osc.ResourceTag{{Key: "foo", Value: "bar"}, {Key: "baz/qux", Value: ""}}
+		assert.True(t, tags.NewFilter().Match(tgs))
+		assert.True(t, tags.NewFilter().Equal("foo", "bar").Exists("baz/qux").Prefix("baz/").Match(tgs))
+		assert.False(t, tags.NewFilter().Equal("foo", "baz").Match(tgs))
+		assert.False(t, tags.NewFilter().Equal("foo", "bar").Prefix("qux/").Match(tgs))
+	})
+	t.Run("Select returns matching items", func(t *testing.T) {
+		ips := []osc.PublicIp{
+			{PublicIpId: "foo", Tags: []osc.ResourceTag{{Key: "pool", Value: "foo"}}},
+			{PublicIpId: "bar", Tags: []osc.ResourceTag{{Key: "pool", Value: "bar"}}},
+		}
+		res := tags.Select(ips, tags.NewFilter().Equal("pool", "bar"), func(ip *osc.PublicIp) []osc.ResourceTag { return ip.Tags })
+		assert.Equal(t, []osc.PublicIp{ips[1]}, res)
+	})
+}
@@ -0,0 +1,219 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/outscale/goutils/sdk/metadata"
+)
+
+// ErrInvalidPlacementPolicy is returned when a placement policy is inconsistent.
+var ErrInvalidPlacementPolicy = errors.New("invalid placement policy")
+
+// PlacementPolicy is the placement policy of a VM, defined by the well-known FCU tags.
+// Group fields store the tag value, VMs sharing the same value belonging to the same group.
+// An empty group means that the tag is not set.
+type PlacementPolicy struct {
+	// RepulseServer places VMs of the group on different servers.
+	RepulseServer string
+	// AttractServer places VMs of the group on the same server.
+	AttractServer string
+	// RepulseCluster places VMs of the group on different clusters.
+	RepulseCluster string
+	// AttractCluster places VMs of the group on the same cluster.
+	AttractCluster string
+	// EIPAutoAttach is the public IP automatically associated with the VM.
+	EIPAutoAttach string
+	// PrivateOnly blocks the attribution of a public IP to the VM.
+	PrivateOnly bool
+}
+
+// ParsePlacementPolicy reads a placement policy from tags.
+func ParsePlacementPolicy(s Set) (PlacementPolicy, error) {
+	p := PlacementPolicy{
+		RepulseServer:  s[RepulseServer],
+		AttractServer:  s[AttractServer],
+		RepulseCluster: s[RepulseCluster],
+		AttractCluster: s[AttractCluster],
+		EIPAutoAttach:  s[EIPAutoAttach],
+	}
+	if v, found := s[PrivateOnly]; found {
+		var err error
+		p.PrivateOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			return PlacementPolicy{}, fmt.Errorf("%w: %s: %w", ErrInvalidPlacementPolicy, PrivateOnly, err)
+		}
+	}
+	return p, nil
+}
+
+// Set returns the tags defining the placement policy.
+func (p PlacementPolicy) Set() Set {
+	s := Set{}
+	for k, v := range map[string]string{
+		RepulseServer:  p.RepulseServer,
+		AttractServer:  p.AttractServer,
+		RepulseCluster: p.RepulseCluster,
+		AttractCluster: p.AttractCluster,
+		EIPAutoAttach:  p.EIPAutoAttach,
+	} {
+		if v != "" {
+			s[k] = v
+		}
+	}
+	if p.PrivateOnly {
+		s[PrivateOnly] = "true"
+	}
+	return s
+}
+
+// Validate checks that a placement policy is consistent.
+func (p PlacementPolicy) Validate() error {
+	var errs []error
+	conflict := func(a, b, va, vb string) {
+		if va != "" && va == vb {
+			errs = append(errs, fmt.Errorf("%w: %s and %s cannot use the same group %q", ErrInvalidPlacementPolicy, a, b, va))
+		}
+	}
+	conflict(AttractServer, RepulseServer, p.AttractServer, p.RepulseServer)
+	conflict(AttractCluster, RepulseCluster, p.AttractCluster, p.RepulseCluster)
+	// VMs on the same server are on the same cluster.
+	conflict(AttractServer, RepulseCluster, p.AttractServer, p.RepulseCluster)
+	if p.PrivateOnly && p.EIPAutoAttach != "" {
+		errs = append(errs, fmt.Errorf("%w: %s cannot be used with %s", ErrInvalidPlacementPolicy, EIPAutoAttach, PrivateOnly))
+	}
+	for k, v := range p.Set() {
+		if err := ValidateValue(k, v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// VmPlacement is the actual placement of a VM, with its policy.
+type VmPlacement struct {
+	VmID      string
+	Policy    PlacementPolicy
+	Placement metadata.Placement
+}
+
+// LocalVmPlacement fetches the placement and policy of the local VM from the metadata server.
+func LocalVmPlacement(ctx context.Context, svc *metadata.Service) (VmPlacement, error) {
+	id, err := svc.GetInstanceID(ctx)
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	az, err := svc.GetSubregion(ctx)
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	cluster, err := svc.GetPlacementCluster(ctx)
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	server, err := svc.GetPlacementServer(ctx)
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	tags, err := svc.GetTags(ctx)
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	policy, err := ParsePlacementPolicy(FromMap(tags))
+	if err != nil {
+		return VmPlacement{}, err
+	}
+	return VmPlacement{
+		VmID:      id,
+		Policy:    policy,
+		Placement: metadata.Placement{Subregion: az, Cluster: cluster, Server: server},
+	}, nil
+}
+
+// PlacementStatus is the status of a placement check.
+type PlacementStatus string
+
+const (
+	// PlacementSatisfied is used when the policy holds.
+	PlacementSatisfied PlacementStatus = "satisfied"
+	// PlacementViolated is used when the policy does not hold.
+	PlacementViolated PlacementStatus = "violated"
+	// PlacementUnknown is used when the placement of a VM is unknown.
+	PlacementUnknown PlacementStatus = "unknown"
+)
+
+// PlacementCheck is the result of the check of a policy tag between two VMs.
+type PlacementCheck struct {
+	Tag      string
+	Group    string
+	PeerVmID string
+	Status   PlacementStatus
+	Reason   string
+}
+
+// PlacementExplanation lists all checks of the policy of a VM.
+type PlacementExplanation struct {
+	VmID   string
+	Checks []PlacementCheck
+}
+
+// Violations returns the checks that are not satisfied.
+func (e PlacementExplanation) Violations() []PlacementCheck {
+	var res []PlacementCheck
+	for _, c := range e.Checks {
+		if c.Status != PlacementSatisfied {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// Satisfied checks if all checks are satisfied.
+func (e PlacementExplanation) Satisfied() bool {
+	return len(e.Violations()) == 0
+}
+
+// ExplainPlacement checks the placement of a VM against its policy and the placement of its peers.
+// Peers not sharing any group with the VM are ignored.
+func ExplainPlacement(vm VmPlacement, peers []VmPlacement) PlacementExplanation {
+	exp := PlacementExplanation{VmID: vm.VmID}
+	check := func(peer VmPlacement, tag, group, peerGroup, level, actual, peerActual string, attract bool) {
+		if group == "" || group != peerGroup {
+			return
+		}
+		c := PlacementCheck{Tag: tag, Group: group, PeerVmID: peer.VmID}
+		switch {
+		case actual == "" || peerActual == "":
+			c.Status = PlacementUnknown
+			c.Reason = fmt.Sprintf("%s of %s or %s is unknown", level, vm.VmID, peer.VmID)
+		case attract == (actual == peerActual):
+			c.Status = PlacementSatisfied
+			c.Reason = fmt.Sprintf("%s is on %s %s, %s is on %s %s", vm.VmID, level, actual, peer.VmID, level, peerActual)
+		case attract:
+			c.Status = PlacementViolated
+			c.Reason = fmt.Sprintf("%s and %s should be on the same %s, found %s and %s", vm.VmID, peer.VmID, level, actual, peerActual)
+		default:
+			c.Status = PlacementViolated
+			c.Reason = fmt.Sprintf("%s and %s should be on different %ss, both are on %s", vm.VmID, peer.VmID, level, actual)
+		}
+		exp.Checks = append(exp.Checks, c)
+	}
+	for _, peer := range peers {
+		if peer.VmID == vm.VmID {
+			continue
+		}
+		p, pp := vm.Policy, peer.Policy
+		check(peer, RepulseServer, p.RepulseServer, pp.RepulseServer, "server", vm.Placement.Server, peer.Placement.Server, false)
+		check(peer, AttractServer, p.AttractServer, pp.AttractServer, "server", vm.Placement.Server, peer.Placement.Server, true)
+		check(peer, RepulseCluster, p.RepulseCluster, pp.RepulseCluster, "cluster", vm.Placement.Cluster, peer.Placement.Cluster, false)
+		check(peer, AttractCluster, p.AttractCluster, pp.AttractCluster, "cluster", vm.Placement.Cluster, peer.Placement.Cluster, true)
+	}
+	return exp
+}
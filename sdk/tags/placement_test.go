@@ -0,0 +1,84 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/sdk/metadata"
+	"github.com/outscale/goutils/sdk/metadata/mocks_metadata"
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementPolicy(t *testing.T) {
+	t.Run("A policy can be converted from/to tags", func(t *testing.T) {
+		p := tags.PlacementPolicy{RepulseServer: "foo", AttractCluster: "bar", PrivateOnly: true}
+		s := p.Set()
+		assert.Equal(t, tags.Set{tags.RepulseServer: "foo", tags.AttractCluster: "bar", tags.PrivateOnly: "true"}, s)
+		pp, err := tags.ParsePlacementPolicy(s)
+		require.NoError(t, err)
+		assert.Equal(t, p, pp)
+	})
+	t.Run("Invalid boolean values are rejected", func(t *testing.T) {
+		_, err := tags.ParsePlacementPolicy(tags.Set{tags.PrivateOnly: "maybe"})
+		require.ErrorIs(t, err, tags.ErrInvalidPlacementPolicy)
+	})
+	t.Run("Inconsistent policies are rejected", func(t *testing.T) {
+		require.NoError(t, tags.PlacementPolicy{RepulseServer: "foo", AttractCluster: "foo"}.Validate())
+		require.ErrorIs(t, tags.PlacementPolicy{RepulseServer: "foo", AttractServer: "foo"}.Validate(), tags.ErrInvalidPlacementPolicy)
+		require.ErrorIs(t, tags.PlacementPolicy{RepulseCluster: "foo", AttractCluster: "foo"}.Validate(), tags.ErrInvalidPlacementPolicy)
+		require.ErrorIs(t, tags.PlacementPolicy{RepulseCluster: "foo", AttractServer: "foo"}.Validate(), tags.ErrInvalidPlacementPolicy)
+		require.ErrorIs(t, tags.PlacementPolicy{PrivateOnly: true, EIPAutoAttach: "1.2.3.4"}.Validate(), tags.ErrInvalidPlacementPolicy)
+	})
+}
+
+func TestExplainPlacement(t *testing.T) {
+	policy := tags.PlacementPolicy{RepulseServer: "workers", AttractCluster: "workers"}
+	vm := tags.VmPlacement{VmID: "i-foo", Policy: policy, Placement: metadata.Placement{Cluster: "c1", Server: "s1"}}
+	t.Run("A satisfied policy is reported", func(t *testing.T) {
+		exp := tags.ExplainPlacement(vm, []tags.VmPlacement{
+			vm,
+			{VmID: "i-bar", Policy: policy, Placement: metadata.Placement{Cluster: "c1", Server: "s2"}},
+			{VmID: "i-baz", Placement: metadata.Placement{Cluster: "c1", Server: "s1"}},
+		})
+		assert.True(t, exp.Satisfied())
+		assert.Len(t, exp.Checks, 2)
+	})
+	t.Run("Violations are reported", func(t *testing.T) {
+		exp := tags.ExplainPlacement(vm, []tags.VmPlacement{
+			{VmID: "i-bar", Policy: policy, Placement: metadata.Placement{Cluster: "c2", Server: "s1"}},
+			{VmID: "i-baz", Policy: policy},
+		})
+		assert.False(t, exp.Satisfied())
+		violations := exp.Violations()
+		require.Len(t, violations, 4)
+		assert.Equal(t, tags.PlacementCheck{
+			Tag: tags.RepulseServer, Group: "workers", PeerVmID: "i-bar", Status: tags.PlacementViolated,
+			Reason: "i-foo and i-bar should be on different servers, both are on s1",
+		}, violations[0])
+		assert.Equal(t, tags.PlacementViolated, violations[1].Status)
+		assert.Equal(t, tags.PlacementUnknown, violations[2].Status)
+	})
+}
+
+func TestLocalVmPlacement(t *testing.T) {
+	mocks_metadata.Setup()
+	defer mocks_metadata.Teardown()
+
+	mocks_metadata.MockInstanceID("i-foo")
+	mocks_metadata.MockPlacement(metadata.Placement{Subregion: "eu-west-2a", Cluster: "c1", Server: "s1"})
+	mocks_metadata.MockTags(map[string]string{tags.RepulseServer: "workers", "Name": "foo"})
+
+	vm, err := tags.LocalVmPlacement(t.Context(), metadata.DefaultService)
+	require.NoError(t, err)
+	assert.Equal(t, tags.VmPlacement{
+		VmID:      "i-foo",
+		Policy:    tags.PlacementPolicy{RepulseServer: "workers"},
+		Placement: metadata.Placement{Subregion: "eu-west-2a", Cluster: "c1", Server: "s1"},
+	}, vm)
+}
@@ -0,0 +1,107 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	escapeChar = '+'
+	hashLength = 8
+)
+
+// Sanitize converts a string into a string only made of allowed characters, with at most maxLen characters.
+//
+// Forbidden characters and + are escaped as +XX, XX being the hex value of each UTF-8 byte.
+// If the escaped string is too long, it is truncated and suffixed by a hash of the original string.
+// The result is deterministic, and reversible is true if Unsanitize returns the original string.
+func Sanitize(s string, maxLen int) (res string, reversible bool) {
+	return shorten(s, escape(s, false), maxLen)
+}
+
+// escape returns the escaped chunks of s, one per rune.
+func escape(s string, escapeFirst bool) []string {
+	chunks := make([]string, 0, len(s))
+	for i, r := range s {
+		if (i == 0 && escapeFirst) || r == escapeChar || !IsAllowedChar(r) {
+			var sb strings.Builder
+			for _, b := range []byte(string(r)) {
+				fmt.Fprintf(&sb, "%c%02X", escapeChar, b)
+			}
+			chunks = append(chunks, sb.String())
+			continue
+		}
+		chunks = append(chunks, string(r))
+	}
+	return chunks
+}
+
+// shorten joins escaped chunks, truncating on a chunk boundary and adding a hash of s if they are too long,
+// so that escape sequences are never cut.
+func shorten(s string, chunks []string, maxLen int) (res string, reversible bool) {
+	var n int
+	for _, c := range chunks {
+		n += utf8.RuneCountInString(c)
+	}
+	if n <= maxLen {
+		return strings.Join(chunks, ""), true
+	}
+	sum := sha256.Sum256([]byte(s))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+	if maxLen <= hashLength {
+		return hash[:max(maxLen, 0)], false
+	}
+	var sb strings.Builder
+	n = 0
+	for _, c := range chunks {
+		n += utf8.RuneCountInString(c)
+		if n > maxLen-hashLength-1 {
+			break
+		}
+		sb.WriteString(c)
+	}
+	return sb.String() + "-" + hash, false
+}
+
+// SanitizeKey converts a string, e.g. a Kubernetes identifier, into a valid tag key.
+// The first character of keys starting with a reserved prefix is escaped.
+// An empty string is not a valid key and is returned as is.
+func SanitizeKey(s string) (key string, reversible bool) {
+	reserved := hasAnyPrefix(s, ReservedPrefixes) && !slices.Contains(wellKnownReserved, s)
+	return shorten(s, escape(s, reserved), MaxKeyLength)
+}
+
+// SanitizeValue converts a string, e.g. a Kubernetes identifier, into a valid tag value.
+func SanitizeValue(s string) (value string, reversible bool) {
+	return Sanitize(s, MaxValueLength)
+}
+
+// Unsanitize reverts Sanitize, SanitizeKey or SanitizeValue.
+func Unsanitize(s string) (string, error) {
+	var buf []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] != escapeChar {
+			buf = append(buf, s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("unsanitize %q: truncated escape sequence", s)
+		}
+		b, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("unsanitize %q: %w", s, err)
+		}
+		buf = append(buf, b...)
+		i += 2
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,82 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	tts := []struct {
+		in, out    string
+		reversible bool
+	}{
+		{in: "kube-system/my-service", out: "kube-system/my-service", reversible: true},
+		{in: "foo,bar+baz", out: "foo+2Cbar+2Bbaz", reversible: true},
+		{in: "été", out: "été", reversible: true},
+		{in: "foo\nbar", out: "foo+0Abar", reversible: true},
+	}
+	for _, tt := range tts {
+		out, reversible := tags.SanitizeValue(tt.in)
+		assert.Equal(t, tt.out, out)
+		assert.Equal(t, tt.reversible, reversible)
+		require.NoError(t, tags.ValidateValue("foo", out))
+		in, err := tags.Unsanitize(out)
+		require.NoError(t, err)
+		assert.Equal(t, tt.in, in)
+	}
+}
+
+func TestSanitize_Shorten(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	out, reversible := tags.SanitizeValue(long)
+	assert.False(t, reversible)
+	assert.Len(t, out, tags.MaxValueLength)
+	require.NoError(t, tags.ValidateValue("foo", out))
+	out2, _ := tags.SanitizeValue(long)
+	assert.Equal(t, out, out2, "sanitize must be deterministic")
+	out3, _ := tags.SanitizeValue(long + "b")
+	assert.NotEqual(t, out, out3)
+}
+
+func TestSanitize_ShortenEscaped(t *testing.T) {
+	for n := range 4 {
+		long := strings.Repeat("a", n) + strings.Repeat(",é", 200)
+		out, reversible := tags.SanitizeValue(long)
+		assert.False(t, reversible)
+		assert.LessOrEqual(t, len([]rune(out)), tags.MaxValueLength)
+		require.NoError(t, tags.ValidateValue("foo", out))
+		prefix, _, _ := strings.Cut(out, "-")
+		in, err := tags.Unsanitize(prefix)
+		require.NoError(t, err, "escape sequences are not cut")
+		assert.True(t, strings.HasPrefix(long, in))
+	}
+}
+
+func TestSanitizeKey(t *testing.T) {
+	key, reversible := tags.SanitizeKey("osc.foo")
+	assert.Equal(t, "+6Fsc.foo", key)
+	assert.True(t, reversible)
+	require.NoError(t, tags.ValidateKey(key))
+	orig, err := tags.Unsanitize(key)
+	require.NoError(t, err)
+	assert.Equal(t, "osc.foo", orig)
+
+	key, _ = tags.SanitizeKey(tags.RepulseServer)
+	assert.Equal(t, tags.RepulseServer, key)
+}
+
+func TestUnsanitize_Errors(t *testing.T) {
+	_, err := tags.Unsanitize("foo+2")
+	require.Error(t, err)
+	_, err = tags.Unsanitize("foo+ZZ")
+	require.Error(t, err)
+}
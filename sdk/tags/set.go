@@ -0,0 +1,176 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/outscale/goutils/sdk/log"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+// Set is a set of tags, indexed by key.
+type Set map[string]string
+
+// FromSlice builds a set from a tag slice.
+// If a key is found multiple times, the last value is kept.
+func FromSlice(tags []osc.ResourceTag) Set {
+	s := make(Set, len(tags))
+	for _, t := range tags {
+		s[t.Key] = t.Value
+	}
+	return s
+}
+
+// FromMap builds a set from a map.
+func FromMap(m map[string]string) Set {
+	return maps.Clone(Set(m))
+}
+
+// Map returns the set as a map.
+func (s Set) Map() map[string]string {
+	return maps.Clone(map[string]string(s))
+}
+
+// Slice returns the set as a tag slice, sorted by key.
+func (s Set) Slice() []osc.ResourceTag {
+	tags := make([]osc.ResourceTag, 0, len(s))
+	for _, k := range slices.Sorted(maps.Keys(s)) {
+		tags = append(tags, osc.ResourceTag{Key: k, Value: s[k]})
+	}
+	return tags
+}
+
+// Has checks if the set contains a key.
+// If called with a value, it checks if the key exists with the exact value.
+func (s Set) Has(k string, v ...string) bool {
+	val, found := s[k]
+	return found && (len(v) == 0 || v[0] == val)
+}
+
+// Filter returns the subset of tags whose key has one of the prefixes.
+func (s Set) Filter(prefixes ...string) Set {
+	res := Set{}
+	for k, v := range s {
+		if hasAnyPrefix(k, prefixes) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// Diff computes the changes required to go from current to s.
+// create lists the tags that are missing or have a different value in current,
+// remove lists the tags found in current but not in s, with their current value.
+func (s Set) Diff(current Set) (create, remove Set) {
+	create, remove = Set{}, Set{}
+	for k, v := range s {
+		if cv, found := current[k]; !found || cv != v {
+			create[k] = v
+		}
+	}
+	for k, v := range current {
+		if _, found := s[k]; !found {
+			remove[k] = v
+		}
+	}
+	return create, remove
+}
+
+func hasAnyPrefix(k string, prefixes []string) bool {
+	return slices.ContainsFunc(prefixes, func(prefix string) bool {
+		return strings.HasPrefix(k, prefix)
+	})
+}
+
+// Read fetches the tags of a list of resources, indexed by resource ID.
+func Read(ctx context.Context, client osc.ClientInterface, resourceIDs []string) (map[string]Set, error) {
+	res := make(map[string]Set, len(resourceIDs))
+	for _, id := range resourceIDs {
+		res[id] = Set{}
+	}
+	req := osc.ReadTagsRequest{
+		Filters: &osc.FiltersTag{
+			ResourceIds: &resourceIDs,
+		},
+	}
+	for {
+		resp, err := client.ReadTags(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("read tags: %w", err)
+		}
+		for _, t := range ptr.From(resp.Tags) {
+			if s, found := res[t.ResourceId]; found {
+				s[t.Key] = t.Value
+			}
+		}
+		if ptr.From(resp.NextPageToken) == "" {
+			return res, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// Reconcile updates the tags of resources to match desired, using the minimal number of CreateTags/DeleteTags calls.
+// Only keys having one of the managed prefixes are created, updated or deleted, all other keys are left untouched.
+// An empty prefix may be used to manage all keys.
+func Reconcile(ctx context.Context, client osc.ClientInterface, resourceIDs []string, desired Set, managedPrefixes []string) error {
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+	current, err := Read(ctx, client, resourceIDs)
+	if err != nil {
+		return fmt.Errorf("reconcile tags: %w", err)
+	}
+	desired = desired.Filter(managedPrefixes...)
+	// resources needing the same changes are grouped in a single call.
+	var creates, removes changes
+	for _, id := range resourceIDs {
+		create, remove := desired.Diff(current[id].Filter(managedPrefixes...))
+		creates.add(create, id)
+		removes.add(remove, id)
+	}
+	for _, c := range removes {
+		log.FromContext(ctx).V(5).Info(ctx, "Deleting tags", "resourceIds", c.ids, "keys", slices.Sorted(maps.Keys(c.tags)))
+		_, err := client.DeleteTags(ctx, osc.DeleteTagsRequest{ResourceIds: c.ids, Tags: c.tags.Slice()})
+		if err != nil {
+			return fmt.Errorf("reconcile tags: delete tags: %w", err)
+		}
+	}
+	for _, c := range creates {
+		log.FromContext(ctx).V(5).Info(ctx, "Creating tags", "resourceIds", c.ids, "keys", slices.Sorted(maps.Keys(c.tags)))
+		_, err := client.CreateTags(ctx, osc.CreateTagsRequest{ResourceIds: c.ids, Tags: c.tags.Slice()})
+		if err != nil {
+			return fmt.Errorf("reconcile tags: create tags: %w", err)
+		}
+	}
+	return nil
+}
+
+type change struct {
+	tags Set
+	ids  []string
+}
+
+type changes []change
+
+func (cs *changes) add(tags Set, id string) {
+	if len(tags) == 0 {
+		return
+	}
+	for i := range *cs {
+		if maps.Equal((*cs)[i].tags, tags) {
+			(*cs)[i].ids = append((*cs)[i].ids, id)
+			return
+		}
+	}
+	*cs = append(*cs, change{tags: tags, ids: []string{id}})
+}
@@ -0,0 +1,80 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags_test
+
+import (
+	"testing"
+
+	"github.com/outscale/goutils/sdk/mocks_osc"
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("A set can be converted from/to a slice", func(t *testing.T) {
+		tgs := []osc.ResourceTag{{Key: "foo", Value: "bar"}, {Key: "baz", Value: "qux"}}
+		s := tags.FromSlice(tgs)
+		assert.Equal(t, map[string]string{"foo": "bar", "baz": "qux"}, s.Map())
+		assert.Equal(t, []osc.ResourceTag{{Key: "baz", Value: "qux"}, {Key: "foo", Value: "bar"}}, s.Slice())
+	})
+	t.Run("Diff returns tags to create and to remove", func(t *testing.T) {
+		desired := tags.Set{"foo": "bar", "baz": "qux"}
+		current := tags.Set{"foo": "bar", "baz": "quux", "old": "value"}
+		create, remove := desired.Diff(current)
+		assert.Equal(t, tags.Set{"baz": "qux"}, create)
+		assert.Equal(t, tags.Set{"old": "value"}, remove)
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("Only tags with a managed prefix are changed, with a call per group of resources", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Eq(osc.ReadTagsRequest{
+			Filters: &osc.FiltersTag{ResourceIds: &[]string{"vol-foo", "vol-bar"}},
+		})).Return(&osc.ReadTagsResponse{Tags: &[]osc.Tag{
+			{ResourceId: "vol-foo", Key: "Name", Value: "foo"},
+			{ResourceId: "vol-foo", Key: "k8s/old", Value: "value"},
+			{ResourceId: "vol-bar", Key: "Name", Value: "bar"},
+			{ResourceId: "vol-bar", Key: "k8s/old", Value: "value"},
+			{ResourceId: "vol-bar", Key: "k8s/foo", Value: "bar"},
+		}}, nil)
+		mockSDK.EXPECT().DeleteTags(gomock.Any(), gomock.Eq(osc.DeleteTagsRequest{
+			ResourceIds: []string{"vol-foo", "vol-bar"},
+			Tags:        []osc.ResourceTag{{Key: "k8s/old", Value: "value"}},
+		})).Return(&osc.DeleteTagsResponse{}, nil)
+		mockSDK.EXPECT().CreateTags(gomock.Any(), gomock.Eq(osc.CreateTagsRequest{
+			ResourceIds: []string{"vol-foo"},
+			Tags:        []osc.ResourceTag{{Key: "k8s/foo", Value: "bar"}},
+		})).Return(&osc.CreateTagsResponse{}, nil)
+
+		err := tags.Reconcile(t.Context(), mockSDK, []string{"vol-foo", "vol-bar"},
+			tags.Set{"k8s/foo": "bar", "Name": "ignored"}, []string{"k8s/"})
+		require.NoError(t, err)
+	})
+	t.Run("Tags are read using pagination", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockSDK := mocks_osc.NewMockClient(mockCtrl)
+		gomock.InOrder(
+			mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Any()).Return(&osc.ReadTagsResponse{
+				Tags:          &[]osc.Tag{{ResourceId: "vol-foo", Key: "foo", Value: "bar"}},
+				NextPageToken: ptr.To("next"),
+			}, nil),
+			mockSDK.EXPECT().ReadTags(gomock.Any(), gomock.Cond(func(req osc.ReadTagsRequest) bool {
+				return ptr.From(req.NextPageToken) == "next"
+			})).Return(&osc.ReadTagsResponse{
+				Tags: &[]osc.Tag{{ResourceId: "vol-foo", Key: "baz", Value: "qux"}},
+			}, nil),
+		)
+
+		err := tags.Reconcile(t.Context(), mockSDK, []string{"vol-foo"}, tags.Set{"foo": "bar", "baz": "qux"}, []string{""})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+)
+
+const (
+	// MaxKeyLength is the maximum length of a tag key, in characters.
+	MaxKeyLength = 255
+	// MaxValueLength is the maximum length of a tag value, in characters.
+	MaxValueLength = 255
+)
+
+// ReservedPrefixes lists the key prefixes that are reserved by the platform.
+// The well-known osc.fcu.* tags are still allowed.
+var ReservedPrefixes = []string{"aws:", "osc."}
+
+var wellKnownReserved = []string{EIPAutoAttach, RepulseServer, AttractServer, RepulseCluster, AttractCluster}
+
+// ErrInvalidTag is wrapped by all validation errors.
+var ErrInvalidTag = errors.New("invalid tag")
+
+// Reason is the reason why a tag is invalid.
+type Reason string
+
+const (
+	// ReasonEmptyKey is used when a key is empty.
+	ReasonEmptyKey Reason = "empty key"
+	// ReasonKeyTooLong is used when a key is longer than MaxKeyLength.
+	ReasonKeyTooLong Reason = "key too long"
+	// ReasonValueTooLong is used when a value is longer than MaxValueLength.
+	ReasonValueTooLong Reason = "value too long"
+	// ReasonInvalidKeyChar is used when a key contains a forbidden character.
+	ReasonInvalidKeyChar Reason = "invalid character in key"
+	// ReasonInvalidValueChar is used when a value contains a forbidden character.
+	ReasonInvalidValueChar Reason = "invalid character in value"
+	// ReasonReservedPrefix is used when a key uses a reserved prefix.
+	ReasonReservedPrefix Reason = "reserved key prefix"
+)
+
+// ValidationError is returned when a tag is invalid.
+type ValidationError struct {
+	Key    string
+	Value  string
+	Reason Reason
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid tag %q: %s", e.Key, e.Reason)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidTag
+}
+
+// IsAllowedChar checks if a character is allowed in tag keys and values.
+// Allowed characters are letters, digits, spaces, and _ . : / = + - @.
+func IsAllowedChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ' || strings.ContainsRune("_.:/=+-@", r)
+}
+
+func newValidationError(key, value string, reason Reason) error {
+	return &ValidationError{Key: key, Value: value, Reason: reason}
+}
+
+// ValidateKey checks that a tag key is valid.
+func ValidateKey(key string) error {
+	switch {
+	case key == "":
+		return newValidationError(key, "", ReasonEmptyKey)
+	case utf8.RuneCountInString(key) > MaxKeyLength:
+		return newValidationError(key, "", ReasonKeyTooLong)
+	case strings.IndexFunc(key, func(r rune) bool { return !IsAllowedChar(r) }) >= 0:
+		return newValidationError(key, "", ReasonInvalidKeyChar)
+	case hasAnyPrefix(key, ReservedPrefixes) && !slices.Contains(wellKnownReserved, key):
+		return newValidationError(key, "", ReasonReservedPrefix)
+	}
+	return nil
+}
+
+// ValidateValue checks that the value of a tag is valid.
+func ValidateValue(key, value string) error {
+	switch {
+	case utf8.RuneCountInString(value) > MaxValueLength:
+		return newValidationError(key, value, ReasonValueTooLong)
+	case strings.IndexFunc(value, func(r rune) bool { return !IsAllowedChar(r) }) >= 0:
+		return newValidationError(key, value, ReasonInvalidValueChar)
+	}
+	return nil
+}
+
+// ValidateTag checks that a tag is valid.
+func ValidateTag(key, value string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	return ValidateValue(key, value)
+}
+
+// Validate checks a list of tags, and returns all errors joined.
+// Each error is a *ValidationError, wrapping ErrInvalidTag.
+func Validate(tags []osc.ResourceTag) error {
+	var errs []error
+	for _, t := range tags {
+		if err := ValidateTag(t.Key, t.Value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks all tags of a set.
+func (s Set) Validate() error {
+	return Validate(s.Slice())
+}
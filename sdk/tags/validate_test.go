@@ -0,0 +1,52 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tags_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/outscale/goutils/sdk/tags"
+	"github.com/outscale/osc-sdk-go/v3/pkg/osc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tts := []struct {
+		key, value string
+		reason     tags.Reason
+	}{
+		{key: "OscK8sClusterID/37286b54-bb4b-46c8-ac8a-37621f1e7123", value: "owned"},
+		{key: tags.RepulseServer, value: "foo"},
+		{key: "Name", value: ""},
+		{key: "", value: "foo", reason: tags.ReasonEmptyKey},
+		{key: strings.Repeat("a", 256), reason: tags.ReasonKeyTooLong},
+		{key: "foo", value: strings.Repeat("é", 256), reason: tags.ReasonValueTooLong},
+		{key: "foo,bar", reason: tags.ReasonInvalidKeyChar},
+		{key: "foo", value: "bar\n", reason: tags.ReasonInvalidValueChar},
+		{key: "aws:foo", reason: tags.ReasonReservedPrefix},
+		{key: "osc.foo", reason: tags.ReasonReservedPrefix},
+	}
+	for _, tt := range tts {
+		err := tags.ValidateTag(tt.key, tt.value)
+		if tt.reason == "" {
+			require.NoError(t, err, tt.key)
+			continue
+		}
+		require.ErrorIs(t, err, tags.ErrInvalidTag)
+		var verr *tags.ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Equal(t, tt.reason, verr.Reason)
+		assert.Equal(t, tt.key, verr.Key)
+	}
+}
+
+func TestValidate_All(t *testing.T) {
+	err := tags.Validate([]osc.ResourceTag{{Key: "foo", Value: "bar"}, {Key: ""}, {Key: "aws:foo"}})
+	require.ErrorIs(t, err, tags.ErrInvalidTag)
+	assert.Len(t, strings.Split(err.Error(), "\n"), 2)
+}
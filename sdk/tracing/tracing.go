@@ -0,0 +1,115 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+
+// Package tracing provides OpenTelemetry instrumentation of OAPI calls.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of tracers.
+const ScopeName = "github.com/outscale/goutils/sdk/tracing"
+
+// Span attributes.
+const (
+	AttrCall       = attribute.Key("oapi.call")
+	AttrStatusCode = attribute.Key("http.response.status_code")
+	AttrRetries    = attribute.Key("oapi.retries")
+)
+
+// Tracer returns the tracer of a provider, otel.GetTracerProvider() being used if tp is nil.
+func Tracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(ScopeName)
+}
+
+// WithTracing traces OAPI calls. It replaces the retry middleware, which is wrapped, and needs to be set after
+// any retry option. retry may be nil if calls are not retried.
+func WithTracing(tp trace.TracerProvider, retry middleware.Middleware) middleware.MiddlewareChainOption {
+	return middleware.WithMiddleware(middleware.MiddlewareSlotRetry, &Middleware{TracerProvider: tp, Next: retry})
+}
+
+// Middleware wraps a middleware in a span per OAPI call. Attempts made by the wrapped middleware are recorded
+// as span events.
+type Middleware struct {
+	// TracerProvider defaults to otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+	// Next is the wrapped middleware, usually the retry middleware.
+	Next middleware.Middleware
+}
+
+func (m *Middleware) Decorate(next http.RoundTripper) http.RoundTripper {
+	next = &attemptRoundTripper{inner: next}
+	if m.Next != nil {
+		next = m.Next.Decorate(next)
+	}
+	return &spanRoundTripper{inner: next, tracer: Tracer(m.TracerProvider)}
+}
+
+type attemptsKey struct{}
+
+type spanRoundTripper struct {
+	inner  http.RoundTripper
+	tracer trace.Tracer
+}
+
+func (t *spanRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := path.Base(req.URL.Path)
+	ctx, span := t.tracer.Start(req.Context(), call,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(AttrCall.String(call)))
+	defer span.End()
+	var attempts int
+	ctx = context.WithValue(ctx, attemptsKey{}, &attempts)
+	resp, err := t.inner.RoundTrip(req.WithContext(ctx))
+	span.SetAttributes(AttrRetries.Int(max(attempts-1, 0)))
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	case resp.StatusCode > 299:
+		span.SetAttributes(AttrStatusCode.Int(resp.StatusCode))
+		span.SetStatus(codes.Error, resp.Status)
+	default:
+		span.SetAttributes(AttrStatusCode.Int(resp.StatusCode))
+	}
+	return resp, err
+}
+
+type attemptRoundTripper struct {
+	inner http.RoundTripper
+}
+
+func (t *attemptRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempts, ok := ctx.Value(attemptsKey{}).(*int)
+	if ok {
+		*attempts++
+	}
+	resp, err := t.inner.RoundTrip(req)
+	if ok {
+		attrs := []attribute.KeyValue{attribute.Int("attempt", *attempts)}
+		if resp != nil {
+			attrs = append(attrs, AttrStatusCode.Int(resp.StatusCode))
+		}
+		if err != nil {
+			attrs = append(attrs, attribute.String("error", err.Error()))
+		}
+		trace.SpanFromContext(ctx).AddEvent("attempt", trace.WithAttributes(attrs...))
+	}
+	return resp, err
+}
@@ -0,0 +1,89 @@
+/*
+SPDX-FileCopyrightText: 2025 Outscale SAS <opensource@outscale.com>
+
+SPDX-License-Identifier: BSD-3-Clause
+*/
+package tracing_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/outscale/goutils/sdk/ptr"
+	"github.com/outscale/goutils/sdk/tracing"
+	"github.com/outscale/osc-sdk-go/v3/pkg/middleware/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func statuses(codes ...int) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		code := codes[0]
+		codes = codes[1:]
+		return &http.Response{StatusCode: code, Status: http.StatusText(code), Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+	})
+}
+
+func attr(attrs []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestMiddleware(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	m := &tracing.Middleware{
+		TracerProvider: tp,
+		Next:           &retry.RetryMiddleware{RetryWaitMin: ptr.To(time.Millisecond), RetryWaitMax: ptr.To(time.Millisecond), RetryMax: ptr.To(3)},
+	}
+	call := func(t *testing.T, rt http.RoundTripper) *http.Response {
+		ctx, parent := tp.Tracer("test").Start(t.Context(), "reconcile")
+		defer parent.End()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.eu-west-2.outscale.com/api/v1/ReadVms", strings.NewReader("{}"))
+		require.NoError(t, err)
+		resp, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		return resp
+	}
+	t.Run("A single span is created per call, including retries", func(t *testing.T) {
+		exp.Reset()
+		resp := call(t, m.Decorate(statuses(http.StatusServiceUnavailable, http.StatusOK)))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		spans := exp.GetSpans()
+		require.Len(t, spans, 2)
+		span, parent := spans[0], spans[1]
+		assert.Equal(t, "ReadVms", span.Name)
+		assert.Equal(t, parent.SpanContext.SpanID(), span.Parent.SpanID())
+		assert.Equal(t, "ReadVms", attr(span.Attributes, tracing.AttrCall).AsString())
+		assert.Equal(t, int64(http.StatusOK), attr(span.Attributes, tracing.AttrStatusCode).AsInt64())
+		assert.Equal(t, int64(1), attr(span.Attributes, tracing.AttrRetries).AsInt64())
+		assert.Len(t, span.Events, 2)
+		assert.Equal(t, codes.Unset, span.Status.Code)
+	})
+	t.Run("Error responses set the span status", func(t *testing.T) {
+		exp.Reset()
+		m := &tracing.Middleware{TracerProvider: tp}
+		resp := call(t, m.Decorate(statuses(http.StatusBadRequest)))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		span := exp.GetSpans()[0]
+		assert.Equal(t, int64(0), attr(span.Attributes, tracing.AttrRetries).AsInt64())
+		assert.Equal(t, codes.Error, span.Status.Code)
+	})
+}